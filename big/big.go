@@ -1,10 +1,21 @@
 package big
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"strconv"
+	"strings"
 )
 
+// MaxExponent ограничивает порядок при разборе строк: Parse отклоняет
+// "1e30000000" и "1e-50000000", которые иначе развернулись бы в миллионы цифр
+const MaxExponent = 10000
+
+// ErrExponentRange возвращается Parse, если порядок числа превышает MaxExponent
+var ErrExponentRange = errors.New("decimal exponent out of range")
+
 // Number constraint определяет типы, которые могут быть использованы в универсальном конструкторе
 type Number interface {
 	int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64 | big.Int | big.Float | string
@@ -18,11 +29,14 @@ const (
 	Decimal
 )
 
-// Big представляет большое число, которое может быть целым или десятичным
+// Big представляет большое число, которое может быть целым или десятичным.
+// Десятичное число хранится точно: как немасштабированное целое и количество
+// знаков после запятой, поэтому 0.1 + 0.2 равно ровно 0.3
 type Big struct {
-	typ Type
-	int *big.Int   // Используется, когда тип - Integer
-	dec *big.Float // Используется, когда тип - Decimal
+	typ   Type
+	int   *big.Int // Используется, когда тип - Integer
+	dec   *big.Int // Немасштабированное значение, когда тип - Decimal
+	scale int32    // Количество знаков после запятой, когда тип - Decimal
 }
 
 // New[T Number] создает новый объект Big на основе переданного значения
@@ -30,41 +44,76 @@ func New[T Number](value ...T) *Big {
 	if len(value) == 0 {
 		return newInteger(0)
 	}
-	switch any(value[0]).(type) {
-	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+	switch v := any(value[0]).(type) {
+	case uint, uint64:
+		// Беззнаковые значения могут не поместиться в int64
+		return newIntegerFromBigInt(new(big.Int).SetUint64(toUint64(v)))
+	case int, int8, int16, int32, int64, uint8, uint16, uint32:
 		// Преобразуем в int64 для создания целого числа
 		return newInteger(toInt64(value[0]))
-	case float32, float64:
-		// Преобразуем в float64 для создания десятичного числа
-		return newDecimal(toFloat64(value[0]))
+	case float32:
+		return newDecimalFromFloat(float64(v), 32)
+	case float64:
+		return newDecimalFromFloat(v, 64)
 	case big.Int:
-		v := any(value[0]).(big.Int)
 		return newIntegerFromBigInt(&v)
 	case big.Float:
-		v := any(value[0]).(big.Float)
 		return newDecimalFromBigFloat(&v)
 	case string:
-		return parse(any(value[0]).(string))  // Вызов Parse, который нужно определить
+		return parse(v)
 	default:
 		// Возвращаем нулевое значение для неожиданного типа
 		return newInteger(0)
 	}
 }
 
+// FromUnscaled создает десятичное число unscaled * 10^(-scale)
+func FromUnscaled(unscaled *big.Int, scale int32) *Big {
+	return newDecimalScaled(new(big.Int).Set(unscaled), scale)
+}
+
+// Parse разбирает строку в Big: запись без точки и экспоненты дает Integer,
+// иначе Decimal с сохранением всех цифр ("1.50" остается с двумя знаками)
+func Parse(s string) (*Big, error) {
+	// Пробуем сначала распознать как целое число
+	if i, ok := new(big.Int).SetString(s, 10); ok {
+		return newIntegerFromBigInt(i), nil
+	}
+
+	// Если не удалось как целое, пробуем как десятичное
+	return newDecimalFromString(s)
+}
+
+// FromRat округляет дробь до scale знаков после запятой способом mode
+func FromRat(r *big.Rat, scale int32, mode RoundingMode) *Big {
+	return rescaleRat(r, scale, mode)
+}
+
+// FromRatExact возвращает точное десятичное представление дроби.
+// Второй результат ложен, если дробь не имеет конечной десятичной записи (например, 1/3)
+func FromRatExact(r *big.Rat) (*Big, bool) {
+	return decimalFromRatExact(r)
+}
+
+// Zero возвращает целое 0. Получатель не используется, поэтому метод можно
+// вызывать и на nil: обобщенные алгоритмы получают так нейтральные элементы
+func (*Big) Zero() *Big {
+	return newInteger(0)
+}
+
+// One возвращает целое 1. Получатель не используется
+func (*Big) One() *Big {
+	return newInteger(1)
+}
+
 // parse парсит строку и возвращает объект Big
 func parse(s string) *Big {
-    // Пробуем сначала распознать как целое число
-    if i, ok := new(big.Int).SetString(s, 10); ok {
-        return newIntegerFromBigInt(i)
-    }
-
-    // Если не удалось как целое, пробуем как десятичное
-    if f, ok := new(big.Float).SetString(s); ok {
-        return newDecimalFromBigFloat(f)
-    }
+	if b, err := Parse(s); err == nil {
+		return b
+	}
 
-    // Если оба способа не удались, возвращаем 0
-    return newInteger(0)
+	// Если оба способа не удались, возвращаем 0
+	return newInteger(0)
 }
 
 // Вспомогательная функция для преобразования чисел в int64
@@ -95,16 +144,15 @@ func toInt64[T Number](value T) int64 {
 	}
 }
 
-// Вспомогательная функция для преобразования чисел в float64
-func toFloat64[T Number](value T) float64 {
-	switch v := any(value).(type) {
-	case float32:
-		return float64(v)
-	case float64:
+// Вспомогательная функция для преобразования беззнаковых чисел в uint64
+func toUint64(value any) uint64 {
+	switch v := value.(type) {
+	case uint:
+		return uint64(v)
+	case uint64:
 		return v
 	default:
-		// Если это целое число, преобразуем его в float64
-		return float64(toInt64(value))
+		return 0
 	}
 }
 
@@ -124,128 +172,175 @@ func newIntegerFromBigInt(value *big.Int) *Big {
 	}
 }
 
-// newDecimal создает новое большое десятичное число из float64
-func newDecimal(value float64) *Big {
+// newDecimalScaled создает десятичное число из немасштабированного значения без копирования.
+// Отрицательный масштаб приводится к нулевому умножением на степень 10
+func newDecimalScaled(unscaled *big.Int, scale int32) *Big {
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(-scale))
+		scale = 0
+	}
 	return &Big{
-		typ: Decimal,
-		dec: big.NewFloat(value),
+		typ:   Decimal,
+		dec:   unscaled,
+		scale: scale,
 	}
 }
 
-// newDecimalFromBigFloat создает новое большое десятичное число из *big.Float
+// newDecimalFromFloat создает десятичное число из кратчайшей записи float,
+// поэтому New(0.1) равно ровно 0.1
+func newDecimalFromFloat(value float64, bitSize int) *Big {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		panic("NaN и бесконечность не могут быть представлены в Big")
+	}
+	d, _ := newDecimalFromString(strconv.FormatFloat(value, 'g', -1, bitSize))
+	return d
+}
+
+// newDecimalFromBigFloat создает десятичное число, точно равное *big.Float
 func newDecimalFromBigFloat(value *big.Float) *Big {
-	return &Big{
-		typ: Decimal,
-		dec: new(big.Float).Set(value),
+	if value.IsInf() {
+		panic("бесконечность не может быть представлена в Big")
 	}
+	r, _ := value.Rat(nil)
+	d, _ := decimalFromRatExact(r)
+	return d
 }
 
-// newDecimalFromString создает новое большое десятичное число из строки
+// newDecimalFromString создает новое большое десятичное число из строки.
+// Принимается обычная и экспоненциальная запись: "-12.50", "1e-3", "2.5E+4"
 func newDecimalFromString(s string) (*Big, error) {
-	f := new(big.Float)
-	_, ok := f.SetString(s)
+	s = strings.TrimSpace(s)
+	mantissa, exp := s, int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		if exp, err = strconv.ParseInt(s[i+1:], 10, 32); err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return nil, fmt.Errorf("%w: %s", ErrExponentRange, s)
+			}
+			return nil, fmt.Errorf("invalid decimal string: %s", s)
+		}
+		if exp > MaxExponent || exp < -MaxExponent {
+			return nil, fmt.Errorf("%w: %s", ErrExponentRange, s)
+		}
+		mantissa = s[:i]
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+	if strings.TrimLeft(digits, "+-") == "" || strings.ContainsAny(digits[1:], "+-") {
+		return nil, fmt.Errorf("invalid decimal string: %s", s)
+	}
+
+	unscaled, ok := new(big.Int).SetString(digits, 10)
 	if !ok {
 		return nil, fmt.Errorf("invalid decimal string: %s", s)
 	}
-	return &Big{
-		typ: Decimal,
-		dec: f,
-	}, nil
+
+	// Отрицательный масштаб разворачивается в нули, положительный больше длины
+	// записи - в нули после запятой: оба ограничены MaxExponent
+	scale := int64(len(fracPart)) - exp
+	if scale < -MaxExponent || scale > int64(len(fracPart))+MaxExponent {
+		return nil, fmt.Errorf("%w: %s", ErrExponentRange, s)
+	}
+	return newDecimalScaled(unscaled, int32(scale)), nil
 }
 
 // Add складывает два больших числа
 func (b *Big) Add(other *Big) *Big {
-	// Convert to decimal for calculation to maintain consistent return type
-	bDec := b.ToDecimal()
-	otherDec := other.ToDecimal()
-	result := new(big.Float).Add(bDec.dec, otherDec.dec)
-	return newDecimalFromBigFloat(result)
+	x, y, scale := align(b, other)
+	return newDecimalScaled(x.Add(x, y), scale)
 }
 
 // Subtract вычитает одно большое число из другого
 func (b *Big) Subtract(other *Big) *Big {
-	// Convert to decimal for calculation to maintain consistent return type
-	bDec := b.ToDecimal()
-	otherDec := other.ToDecimal()
-	result := new(big.Float).Sub(bDec.dec, otherDec.dec)
-	return newDecimalFromBigFloat(result)
+	x, y, scale := align(b, other)
+	return newDecimalScaled(x.Sub(x, y), scale)
 }
 
 // Multiply перемножает два больших числа
 func (b *Big) Multiply(other *Big) *Big {
-	// Convert to decimal for calculation to maintain consistent return type
-	bDec := b.ToDecimal()
-	otherDec := other.ToDecimal()
-	result := new(big.Float).Mul(bDec.dec, otherDec.dec)
-	return newDecimalFromBigFloat(result)
+	x, xs := b.unscaled()
+	y, ys := other.unscaled()
+	return newDecimalScaled(new(big.Int).Mul(x, y), xs+ys)
 }
 
-// Divide делит одно большое число на другое
+// Divide делит одно большое число на другое.
+// Неточный результат округляется по DefaultContext
 func (b *Big) Divide(other *Big) *Big {
+	return b.DivideContext(other, DefaultContext)
+}
+
+// DivideContext делит одно большое число на другое.
+// Точный результат возвращается как есть, иначе он округляется до ctx.Precision значащих цифр
+func (b *Big) DivideContext(other *Big, ctx Context) *Big {
 	if other.IsZero() {
 		panic("деление на ноль")
 	}
 
-	// Convert to decimal for calculation to preserve precision
-	bDec := b.ToDecimal()
-	otherDec := other.ToDecimal()
-	result := new(big.Float).Quo(bDec.dec, otherDec.dec)
-	return newDecimalFromBigFloat(result)
-}
-
-// Mod calculates the modulo of two big numbers
-func (b *Big) Mod(other *Big) *Big {
-	// Convert to decimal for calculation to maintain consistent return type
-	bDec := b.ToDecimal()
-	otherDec := other.ToDecimal()
+	c := contextOf([]Context{ctx})
+	x, xs := b.unscaled()
+	y, ys := other.unscaled()
+	q := new(big.Rat).SetFrac(x, y)
+	q.Mul(q, new(big.Rat).SetFrac(pow10(ys), pow10(xs)))
 
-	// Calculate quotient
-	quo := new(big.Float).Quo(bDec.dec, otherDec.dec)
+	preferred := max(xs-ys, 0)
+	return roundRat(q, c).stripZeros(preferred)
+}
 
-	// Truncate to integer part
-	quoInt, _ := quo.Int(nil)
+// DivideToScale делит одно большое число на другое и округляет результат
+// до scale знаков после запятой
+func (b *Big) DivideToScale(other *Big, scale int32, mode RoundingMode) *Big {
+	if other.IsZero() {
+		panic("деление на ноль")
+	}
 
-	// Calculate remainder: remainder = dividend - divisor * quotient_int
-	divisor := otherDec.dec
-	product := new(big.Float).Mul(divisor, new(big.Float).SetInt(quoInt))
-	result := new(big.Float).Sub(bDec.dec, product)
+	x, xs := b.unscaled()
+	y, ys := other.unscaled()
+	q := new(big.Rat).SetFrac(x, y)
+	q.Mul(q, new(big.Rat).SetFrac(pow10(ys), pow10(xs)))
+	return rescaleRat(q, scale, mode)
+}
 
-	return newDecimalFromBigFloat(result)
+// Mod calculates the modulo of two big numbers
+// (the remainder has the sign of the dividend, as in truncated division)
+func (b *Big) Mod(other *Big) *Big {
+	if other.IsZero() {
+		panic("деление на ноль")
+	}
+	x, y, scale := align(b, other)
+	return newDecimalScaled(x.Rem(x, y), scale)
 }
 
-// Pow raises this big number to the power of the exponent
+// Pow raises this big number to the power of the exponent.
+// Negative exponents are computed as 1 / b^-exponent with DefaultContext
 func (b *Big) Pow(exponent int64) *Big {
-	// Convert to decimal for calculation to maintain consistent return type
-	bDec := b.ToDecimal()
-	result := new(big.Float).Set(bDec.dec)
-
-	for i := int64(1); i < exponent; i++ {
-		result.Mul(result, bDec.dec)
+	if exponent < 0 {
+		return newDecimalScaled(big.NewInt(1), 0).Divide(b.Pow(-exponent))
 	}
 
-	return newDecimalFromBigFloat(result)
+	x, scale := b.unscaled()
+	if scale > 0 && exponent > math.MaxInt32/int64(scale) {
+		panic(fmt.Sprintf("масштаб результата Pow выходит за пределы int32: %d * %d", scale, exponent))
+	}
+	result := new(big.Int).Exp(x, big.NewInt(exponent), nil)
+	return newDecimalScaled(result, int32(int64(scale)*exponent))
 }
 
 // Compare compares two big numbers (-1 if less, 0 if equal, 1 if greater)
 func (b *Big) Compare(other *Big) int {
 	if b.typ == Integer && other.typ == Integer {
 		return b.int.Cmp(other.int)
-	} else {
-		// Convert both to decimals for comparison
-		bDec := b.ToDecimal()
-		otherDec := other.ToDecimal()
-
-		return bDec.dec.Cmp(otherDec.dec)
 	}
+	x, y, _ := align(b, other)
+	return x.Cmp(y)
 }
 
 // Sign returns -1 if the number is negative, 0 if zero, 1 if positive
 func (b *Big) Sign() int {
 	if b.typ == Integer {
 		return b.int.Sign()
-	} else {
-		return b.dec.Sign()
 	}
+	return b.dec.Sign()
 }
 
 // IsZero checks if the number is zero
@@ -267,59 +362,76 @@ func (b *Big) IsNegative() bool {
 func (b *Big) ToInteger() *big.Int {
 	if b.typ == Integer {
 		return new(big.Int).Set(b.int)
-	} else {
-		// Convert decimal to integer by truncating
-		intVal, _ := b.dec.Int(nil)
-		return intVal
 	}
+	return new(big.Int).Quo(b.dec, pow10(b.scale))
 }
 
 // ToDecimal converts the number to a big decimal
 func (b *Big) ToDecimal() *Big {
-	if b.typ == Decimal {
-		return &Big{
-			typ: Decimal,
-			dec: new(big.Float).Set(b.dec),
-		}
-	} else {
-		// Convert integer to decimal
-		floatVal := new(big.Float).SetInt(b.int)
-		return &Big{
-			typ: Decimal,
-			dec: floatVal,
-		}
-	}
+	x, scale := b.unscaled()
+	return newDecimalScaled(new(big.Int).Set(x), scale)
 }
 
-// ToFloat64 converts the number to a float64
+// ToFloat64 converts the number to a float64.
+// The flag reports whether the float64 converts back to the same number
 func (b *Big) ToFloat64() (float64, bool) {
 	if b.typ == Integer {
 		f, acc := b.int.Float64()
 		return f, acc == big.Exact
-	} else {
-		f, acc := b.dec.Float64()
-		return f, acc == big.Exact
 	}
+
+	f, _ := new(big.Rat).SetFrac(b.dec, pow10(b.scale)).Float64()
+	if math.IsInf(f, 0) {
+		return f, false
+	}
+	back, err := newDecimalFromString(strconv.FormatFloat(f, 'g', -1, 64))
+	return f, err == nil && back.Compare(b) == 0
 }
 
-// ToInt64 converts the number to an int64
+// ToInt64 converts the number to an int64 (truncating the decimal part).
+// The flag reports whether the integer part fits into int64
 func (b *Big) ToInt64() (int64, bool) {
+	i := b.ToInteger()
+	return i.Int64(), i.IsInt64()
+}
+
+// Rat returns the exact value of the number as a fraction
+func (b *Big) Rat() *big.Rat {
+	return b.rat()
+}
+
+// Type returns the kind of the number: Integer or Decimal
+func (b *Big) Type() Type {
+	return b.typ
+}
+
+// Scale returns the number of digits after the decimal point (0 for integers)
+func (b *Big) Scale() int32 {
 	if b.typ == Integer {
-		return b.int.Int64(), true
-	} else {
-		// Convert decimal to int64 by truncating
-		intVal, _ := b.dec.Int64()
-		return intVal, true
+		return 0
 	}
+	return b.scale
 }
 
-// String returns a string representation of the number
+// Unscaled returns the unscaled value: the number equals Unscaled() * 10^(-Scale())
+func (b *Big) Unscaled() *big.Int {
+	x, _ := b.unscaled()
+	return new(big.Int).Set(x)
+}
+
+// Precision returns the number of significant digits of the unscaled value
+func (b *Big) Precision() int {
+	x, _ := b.unscaled()
+	return digitCount(x)
+}
+
+// String returns a string representation of the number.
+// Decimals are always printed in plain notation without an exponent
 func (b *Big) String() string {
 	if b.typ == Integer {
 		return b.int.String()
-	} else {
-		return b.dec.Text('g', 10)
 	}
+	return formatDecimal(b.dec, b.scale)
 }
 
 // Equals checks if two big numbers are equal
@@ -330,33 +442,39 @@ func (b *Big) Equals(other *Big) bool {
 // Clone creates a copy of the number
 func (b *Big) Clone() *Big {
 	if b.typ == Integer {
-		return &Big{
-			typ: Integer,
-			int: new(big.Int).Set(b.int),
-		}
-	} else {
-		return &Big{
-			typ: Decimal,
-			dec: new(big.Float).Set(b.dec),
-		}
+		return newIntegerFromBigInt(b.int)
 	}
+	return FromUnscaled(b.dec, b.scale)
 }
 
-// Sqrt computes the square root of the number
-func (b *Big) Sqrt() *Big {
-	if b.typ == Integer {
-		// Convert to decimal for square root calculation
-		dec := b.ToDecimal()
-		return dec.Sqrt()
-	} else {
-		if b.dec.Sign() < 0 {
-			panic("квадратный корень отрицательного числа")
-		}
+// Sqrt computes the square root of the number, rounded to ctx.Precision
+// significant digits (DefaultContext by default)
+func (b *Big) Sqrt(ctx ...Context) *Big {
+	if b.Sign() < 0 {
+		panic("квадратный корень отрицательного числа")
+	}
+	if b.IsZero() {
+		return newDecimalScaled(new(big.Int), 0)
+	}
+
+	c := contextOf(ctx)
+	x, scale := b.unscaled()
 
-		result := new(big.Float)
-		result.Sqrt(b.dec)
-		return newDecimalFromBigFloat(result)
+	// Подбираем масштаб результата t так, чтобы получить не меньше Precision+2 цифр:
+	// sqrt(x / 10^scale) = sqrt(x * 10^(2t - scale)) / 10^t
+	t := int32(c.Precision+2) - int32(digitCount(x)-int(scale))/2
+	t = max(t, (scale+1)/2)
+	m := new(big.Int).Mul(x, pow10(2*t-scale))
+	root := new(big.Int).Sqrt(m)
+
+	// Неточный корень дополняем младшей единицей, чтобы округление учло остаток
+	if new(big.Int).Mul(root, root).Cmp(m) != 0 {
+		root.Mul(root, big.NewInt(10)).Add(root, big.NewInt(1))
+		t++
 	}
+
+	preferred := scale / 2
+	return roundRat(new(big.Rat).SetFrac(root, pow10(t)), c).stripZeros(preferred)
 }
 
 // Abs returns the absolute value of the number
@@ -364,8 +482,58 @@ func (b *Big) Abs() *Big {
 	if b.typ == Integer {
 		result := new(big.Int).Abs(b.int)
 		return newIntegerFromBigInt(result)
-	} else {
-		result := new(big.Float).Abs(b.dec)
-		return newDecimalFromBigFloat(result)
 	}
+	return newDecimalScaled(new(big.Int).Abs(b.dec), b.scale)
+}
+
+// Negate returns the number with the opposite sign
+func (b *Big) Negate() *Big {
+	if b.typ == Integer {
+		return newIntegerFromBigInt(new(big.Int).Neg(b.int))
+	}
+	return newDecimalScaled(new(big.Int).Neg(b.dec), b.scale)
+}
+
+// unscaled возвращает немасштабированное значение и масштаб числа без копирования
+func (b *Big) unscaled() (*big.Int, int32) {
+	if b.typ == Integer {
+		return b.int, 0
+	}
+	return b.dec, b.scale
+}
+
+// align приводит два числа к общему масштабу и возвращает копии их немасштабированных значений
+func align(a, b *Big) (*big.Int, *big.Int, int32) {
+	x, xs := a.unscaled()
+	y, ys := b.unscaled()
+	scale := max(xs, ys)
+	return new(big.Int).Mul(x, pow10(scale-xs)), new(big.Int).Mul(y, pow10(scale-ys)), scale
+}
+
+// formatDecimal форматирует unscaled * 10^(-scale) без экспоненты
+func formatDecimal(unscaled *big.Int, scale int32) string {
+	s := new(big.Int).Abs(unscaled).String()
+	if scale > 0 {
+		if pad := int(scale) - len(s) + 1; pad > 0 {
+			s = strings.Repeat("0", pad) + s
+		}
+		s = s[:len(s)-int(scale)] + "." + s[len(s)-int(scale):]
+	}
+	if unscaled.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// pow10 возвращает 10^n для n >= 0
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// digitCount возвращает количество десятичных цифр модуля числа (1 для нуля)
+func digitCount(x *big.Int) int {
+	if x.Sign() == 0 {
+		return 1
+	}
+	return len(new(big.Int).Abs(x).String())
 }
@@ -0,0 +1,222 @@
+package big
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode определяет способ округления десятичного числа
+type RoundingMode uint8
+
+const (
+	// HalfEven округляет к ближайшему, при равенстве - к четному (банковское округление)
+	HalfEven RoundingMode = iota
+	// HalfUp округляет к ближайшему, при равенстве - от нуля
+	HalfUp
+	// HalfDown округляет к ближайшему, при равенстве - к нулю
+	HalfDown
+	// Down отбрасывает лишние цифры (округление к нулю)
+	Down
+	// Up округляет от нуля
+	Up
+	// Ceiling округляет к положительной бесконечности
+	Ceiling
+	// Floor округляет к отрицательной бесконечности
+	Floor
+)
+
+// Context задает точность и способ округления неточных операций
+type Context struct {
+	// Precision - количество значащих цифр результата
+	Precision int
+	// Rounding - способ округления
+	Rounding RoundingMode
+}
+
+// DefaultContext соответствует decimal128: 34 значащие цифры и банковское округление
+var DefaultContext = Context{Precision: 34, Rounding: HalfEven}
+
+// contextOf возвращает переданный контекст или DefaultContext
+func contextOf(ctx []Context) Context {
+	if len(ctx) == 0 {
+		return DefaultContext
+	}
+	c := ctx[0]
+	if c.Precision <= 0 {
+		c.Precision = DefaultContext.Precision
+	}
+	return c
+}
+
+// Round округляет число до ctx.Precision значащих цифр
+func (b *Big) Round(ctx Context) *Big {
+	if ctx.Precision <= 0 {
+		panic("точность должна быть положительной")
+	}
+	x, scale := b.unscaled()
+	if digitCount(x) > ctx.Precision {
+		return roundRat(new(big.Rat).SetFrac(x, pow10(scale)), ctx)
+	}
+	return b.ToDecimal()
+}
+
+// Rescale возвращает число с ровно scale знаками после запятой,
+// округляя лишние цифры способом mode. Отрицательный scale округляет
+// до десятков, сотен и т.д.
+func (b *Big) Rescale(scale int32, mode RoundingMode) *Big {
+	x, xs := b.unscaled()
+	if scale >= xs {
+		return newDecimalScaled(new(big.Int).Mul(x, pow10(scale-xs)), scale)
+	}
+	return rescaleRat(new(big.Rat).SetFrac(x, pow10(xs)), scale, mode)
+}
+
+// Quantize округляет число до того же количества знаков после запятой, что у other
+func (b *Big) Quantize(other *Big, mode RoundingMode) *Big {
+	return b.Rescale(other.Scale(), mode)
+}
+
+// StripTrailingZeros удаляет незначащие нули дробной части
+func (b *Big) StripTrailingZeros() *Big {
+	return b.ToDecimal().stripZeros(0)
+}
+
+// stripZeros удаляет нули дробной части, пока масштаб больше preferred
+func (b *Big) stripZeros(preferred int32) *Big {
+	if b.typ != Decimal {
+		return b
+	}
+	ten := big.NewInt(10)
+	q, r := new(big.Int), new(big.Int)
+	for b.scale > preferred && b.dec.Sign() != 0 {
+		q.QuoRem(b.dec, ten, r)
+		if r.Sign() != 0 {
+			break
+		}
+		b.dec.Set(q)
+		b.scale--
+	}
+	if b.dec.Sign() == 0 && b.scale > preferred {
+		b.scale = preferred
+	}
+	return b
+}
+
+// roundRat округляет дробь до ctx.Precision значащих цифр
+func roundRat(r *big.Rat, ctx Context) *Big {
+	if r.Sign() == 0 {
+		return newDecimalScaled(new(big.Int), 0)
+	}
+
+	// Оцениваем порядок числа по длинам числителя и знаменателя и уточняем его
+	num, den := new(big.Int).Abs(r.Num()), r.Denom()
+	magnitude := digitCount(num) - digitCount(den)
+	if cmpPow10(num, den, magnitude) < 0 {
+		magnitude--
+	}
+
+	// Число имеет magnitude+1 цифр до запятой, оставляем ровно Precision цифр
+	scale := int32(ctx.Precision - magnitude - 1)
+	q := roundQuotient(r, scale, ctx.Rounding)
+
+	// Округление вверх могло добавить цифру (9.99 -> 10.0)
+	if digitCount(q) > ctx.Precision {
+		scale--
+		q = roundQuotient(r, scale, ctx.Rounding)
+	}
+	return newDecimalScaled(q, scale)
+}
+
+// cmpPow10 сравнивает num/den с 10^exp
+func cmpPow10(num, den *big.Int, exp int) int {
+	if exp >= 0 {
+		return num.Cmp(new(big.Int).Mul(den, pow10(int32(exp))))
+	}
+	return new(big.Int).Mul(num, pow10(int32(-exp))).Cmp(den)
+}
+
+// rescaleRat округляет дробь до scale знаков после запятой способом mode
+func rescaleRat(r *big.Rat, scale int32, mode RoundingMode) *Big {
+	return newDecimalScaled(roundQuotient(r, scale, mode), scale)
+}
+
+// roundQuotient возвращает r * 10^scale, округленное до целого способом mode
+func roundQuotient(r *big.Rat, scale int32, mode RoundingMode) *big.Int {
+	num := new(big.Int).Set(r.Num())
+	den := new(big.Int).Set(r.Denom())
+	if scale >= 0 {
+		num.Mul(num, pow10(scale))
+	} else {
+		den.Mul(den, pow10(-scale))
+	}
+
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if roundUp(q, rem, den, num.Sign(), mode) {
+		if num.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// roundUp сообщает, нужно ли увеличить модуль усеченного частного q
+// с остатком rem при делителе den
+func roundUp(q, rem, den *big.Int, sign int, mode RoundingMode) bool {
+	if rem.Sign() == 0 {
+		return false
+	}
+
+	// Сравниваем удвоенный остаток с делителем: <0 - меньше половины, 0 - ровно половина
+	half := new(big.Int).Abs(rem)
+	half.Lsh(half, 1)
+	cmp := half.Cmp(den)
+
+	switch mode {
+	case HalfEven:
+		return cmp > 0 || cmp == 0 && q.Bit(0) == 1
+	case HalfUp:
+		return cmp >= 0
+	case HalfDown:
+		return cmp > 0
+	case Down:
+		return false
+	case Up:
+		return true
+	case Ceiling:
+		return sign > 0
+	case Floor:
+		return sign < 0
+	default:
+		panic(fmt.Sprintf("неизвестный способ округления: %d", mode))
+	}
+}
+
+// decimalFromRatExact возвращает точное десятичное представление дроби.
+// Второй результат ложен, если знаменатель содержит множители, кроме 2 и 5
+func decimalFromRatExact(r *big.Rat) (*Big, bool) {
+	den := new(big.Int).Set(r.Denom())
+	num := new(big.Int).Set(r.Num())
+	two, five := big.NewInt(2), big.NewInt(5)
+	m := new(big.Int)
+
+	var twos, fives int32
+	for m.Mod(den, two).Sign() == 0 {
+		den.Quo(den, two)
+		twos++
+	}
+	for m.Mod(den, five).Sign() == 0 {
+		den.Quo(den, five)
+		fives++
+	}
+	if den.Cmp(big.NewInt(1)) != 0 {
+		return nil, false
+	}
+
+	// num / (2^twos * 5^fives) = num * 2^(scale-twos) * 5^(scale-fives) / 10^scale
+	scale := max(twos, fives)
+	num.Mul(num, new(big.Int).Exp(two, big.NewInt(int64(scale-twos)), nil))
+	num.Mul(num, new(big.Int).Exp(five, big.NewInt(int64(scale-fives)), nil))
+	return newDecimalScaled(num, scale), true
+}
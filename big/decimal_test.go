@@ -0,0 +1,183 @@
+package big
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestDecimalExactArithmetic(t *testing.T) {
+	sum := New("0.1").Add(New("0.2"))
+	if sum.String() != "0.3" || !sum.Equals(New("0.3")) {
+		t.Errorf("Expected 0.3, got %s", sum)
+	}
+
+	product := New("1.25").Multiply(New("-0.4"))
+	if product.String() != "-0.500" {
+		t.Errorf("Expected -0.500, got %s", product)
+	}
+
+	if got := New("10.5").Mod(New(3)).String(); got != "1.5" {
+		t.Errorf("Expected 1.5, got %s", got)
+	}
+
+	if got := New("1.5").Pow(0).String(); got != "1" {
+		t.Errorf("Expected 1, got %s", got)
+	}
+	if got := New("0.2").Pow(3).String(); got != "0.008" {
+		t.Errorf("Expected 0.008, got %s", got)
+	}
+}
+
+func TestDecimalNoExponentNotation(t *testing.T) {
+	tests := map[string]string{
+		"1e-10":    "0.0000000001",
+		"1.5E+20":  "150000000000000000000",
+		"-0.00012": "-0.00012",
+		"123.4500": "123.4500",
+	}
+	for input, want := range tests {
+		if got := New(input).String(); got != want {
+			t.Errorf("New(%q) = %s, expected %s", input, got, want)
+		}
+	}
+
+	if got := New(1e-7).String(); got != "0.0000001" {
+		t.Errorf("Expected 0.0000001, got %s", got)
+	}
+}
+
+func TestParseExponentLimit(t *testing.T) {
+	for _, input := range []string{"1e30000000", "1e-50000000", "1e10001", "1E-10001", "1e99999999999"} {
+		if _, err := Parse(input); !errors.Is(err, ErrExponentRange) {
+			t.Errorf("Parse(%q) error = %v, want ErrExponentRange", input, err)
+		}
+	}
+
+	if got, err := Parse("1e10000"); err != nil || len(got.String()) != 10001 {
+		t.Errorf("Parse(1e10000) = %d digits, %v", len(got.String()), err)
+	}
+	if got, err := Parse("-2.5e-10000"); err != nil || !strings.HasSuffix(got.String(), "025") {
+		t.Errorf("Parse(-2.5e-10000) error = %v", err)
+	}
+}
+
+func TestPowScaleOverflow(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(r.(string), "int32") {
+			t.Errorf("Pow with overflowing scale should panic, got %v", r)
+		}
+	}()
+	New("0.01").Pow(1 << 31)
+}
+
+func TestDivideWithContext(t *testing.T) {
+	if got := New(1).Divide(New(4)).String(); got != "0.25" {
+		t.Errorf("Expected 0.25, got %s", got)
+	}
+	if got := New("6.00").Divide(New(2)).String(); got != "3.00" {
+		t.Errorf("Expected 3.00, got %s", got)
+	}
+
+	got := New(2).DivideContext(New(3), Context{Precision: 5, Rounding: HalfEven})
+	if got.String() != "0.66667" {
+		t.Errorf("Expected 0.66667, got %s", got)
+	}
+	got = New(2).DivideContext(New(3), Context{Precision: 5, Rounding: Down})
+	if got.String() != "0.66666" {
+		t.Errorf("Expected 0.66666, got %s", got)
+	}
+	got = New(-200).DivideContext(New(3), Context{Precision: 3, Rounding: Floor})
+	if got.String() != "-66.7" {
+		t.Errorf("Expected -66.7, got %s", got)
+	}
+
+	if got := New(1).Divide(New(3)); got.Precision() != DefaultContext.Precision {
+		t.Errorf("Expected %d digits, got %s", DefaultContext.Precision, got)
+	}
+
+	if got := New(10).DivideToScale(New(3), 2, HalfUp).String(); got != "3.33" {
+		t.Errorf("Expected 3.33, got %s", got)
+	}
+}
+
+func TestRoundingModes(t *testing.T) {
+	tests := []struct {
+		input string
+		mode  RoundingMode
+		want  string
+	}{
+		{"2.5", HalfEven, "2"},
+		{"3.5", HalfEven, "4"},
+		{"-2.5", HalfEven, "-2"},
+		{"2.5", HalfUp, "3"},
+		{"-2.5", HalfUp, "-3"},
+		{"2.5", HalfDown, "2"},
+		{"2.9", Down, "2"},
+		{"-2.9", Down, "-2"},
+		{"2.1", Up, "3"},
+		{"2.1", Ceiling, "3"},
+		{"-2.9", Ceiling, "-2"},
+		{"2.9", Floor, "2"},
+		{"-2.1", Floor, "-3"},
+	}
+	for _, tt := range tests {
+		if got := New(tt.input).Rescale(0, tt.mode).String(); got != tt.want {
+			t.Errorf("Rescale(%s, %d) = %s, expected %s", tt.input, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestRescaleAndQuantize(t *testing.T) {
+	if got := New("1.2").Rescale(3, HalfEven).String(); got != "1.200" {
+		t.Errorf("Expected 1.200, got %s", got)
+	}
+	if got := New("1250").Rescale(-2, HalfEven).String(); got != "1200" {
+		t.Errorf("Expected 1200, got %s", got)
+	}
+	if got := New("19.995").Quantize(New("0.01"), HalfUp).String(); got != "20.00" {
+		t.Errorf("Expected 20.00, got %s", got)
+	}
+	if got := New("9.99").Round(Context{Precision: 2, Rounding: HalfUp}).String(); got != "10" {
+		t.Errorf("Expected 10, got %s", got)
+	}
+	if got := New("1.500").StripTrailingZeros().String(); got != "1.5" {
+		t.Errorf("Expected 1.5, got %s", got)
+	}
+}
+
+func TestDecimalSqrt(t *testing.T) {
+	if got := New("2.25").Sqrt().String(); got != "1.5" {
+		t.Errorf("Expected 1.5, got %s", got)
+	}
+	got := New(2).Sqrt(Context{Precision: 10, Rounding: HalfEven})
+	if got.String() != "1.414213562" {
+		t.Errorf("Expected 1.414213562, got %s", got)
+	}
+}
+
+func TestFromUnscaled(t *testing.T) {
+	d := New("-12.345")
+	if d.Scale() != 3 || d.Unscaled().Int64() != -12345 {
+		t.Errorf("Expected -12345 * 10^-3, got %s * 10^-%d", d.Unscaled(), d.Scale())
+	}
+	if !FromUnscaled(d.Unscaled(), d.Scale()).Equals(d) {
+		t.Errorf("Expected round trip for %s", d)
+	}
+}
+
+func TestFromRat(t *testing.T) {
+	if d, ok := FromRatExact(big.NewRat(7, 40)); !ok || d.String() != "0.175" {
+		t.Errorf("Expected 0.175, got %v, %v", d, ok)
+	}
+	if _, ok := FromRatExact(big.NewRat(1, 3)); ok {
+		t.Errorf("Expected 1/3 to be inexact")
+	}
+	if got := FromRat(big.NewRat(2, 3), 3, HalfUp).String(); got != "0.667" {
+		t.Errorf("Expected 0.667, got %s", got)
+	}
+	if got := New(*big.NewFloat(0.375)).String(); got != "0.375" {
+		t.Errorf("Expected 0.375, got %s", got)
+	}
+}
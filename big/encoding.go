@@ -0,0 +1,202 @@
+package big
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// binaryVersion - версия формата MarshalBinary
+const binaryVersion byte = 1
+
+// orZero возвращает нулевое Integer для нулевого значения Big{}
+func (b *Big) orZero() *Big {
+	if b.int == nil && b.dec == nil {
+		return newInteger(0)
+	}
+	return b
+}
+
+// set заменяет значение b значением other
+func (b *Big) set(other *Big) {
+	*b = *other
+}
+
+// encodedString возвращает запись числа для текстовых форматов. Decimal без дробной
+// части записывается с точкой ("3."), иначе Parse прочитал бы его как Integer
+func (b *Big) encodedString() string {
+	v := b.orZero()
+	if v.typ == Decimal && v.scale == 0 {
+		return v.String() + "."
+	}
+	return v.String()
+}
+
+// MarshalText реализует интерфейс encoding.TextMarshaler
+func (b Big) MarshalText() ([]byte, error) {
+	return []byte(b.encodedString()), nil
+}
+
+// UnmarshalText реализует интерфейс encoding.TextUnmarshaler. Разбор идет через Parse,
+// поэтому порядок больше MaxExponent отклоняется с ErrExponentRange (так же для JSON и Scan)
+func (b *Big) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	b.set(parsed)
+	return nil
+}
+
+// MarshalJSON реализует интерфейс json.Marshaler: число записывается числом JSON (12.50).
+// JSON не допускает точку без цифр после нее, поэтому Decimal без дробной части
+// записывается с нулевым порядком (3e0). Для записи строкой используйте StringJSON
+func (b Big) MarshalJSON() ([]byte, error) {
+	s := b.encodedString()
+	if strings.HasSuffix(s, ".") {
+		s = strings.TrimSuffix(s, ".") + "e0"
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalJSON реализует интерфейс json.Unmarshaler.
+// Принимается как число JSON, так и строка с числом
+func (b *Big) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	return b.UnmarshalText(data)
+}
+
+// StringJSON - Big, который записывается в JSON строкой ("12.50"), а не числом.
+// Строка безопаснее для клиентов, которые читают числа JSON как float64 и теряют
+// точность. Выбирается для отдельного поля или значения:
+//
+//	type Payment struct {
+//		Amount big.StringJSON `json:"amount"`
+//	}
+type StringJSON Big
+
+// Big возвращает значение как *Big
+func (s StringJSON) Big() *Big {
+	b := Big(s)
+	return b.orZero()
+}
+
+// String возвращает десятичную запись числа
+func (s StringJSON) String() string {
+	return s.Big().String()
+}
+
+// MarshalJSON записывает число строкой JSON
+func (s StringJSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Big().encodedString())
+}
+
+// UnmarshalJSON принимает, как и Big, число JSON или строку с числом
+func (s *StringJSON) UnmarshalJSON(data []byte) error {
+	return (*Big)(s).UnmarshalJSON(data)
+}
+
+// Value реализует интерфейс driver.Valuer.
+// Число передается строкой, которую драйверы принимают для NUMERIC/DECIMAL без потери точности
+// (Decimal без дробной части - с точкой, как в MarshalText)
+func (b Big) Value() (driver.Value, error) {
+	return b.encodedString(), nil
+}
+
+// Scan реализует интерфейс sql.Scanner
+func (b *Big) Scan(value any) error {
+	switch v := value.(type) {
+	case string:
+		return b.UnmarshalText([]byte(v))
+	case []byte:
+		return b.UnmarshalText(v)
+	case int64:
+		b.set(newInteger(v))
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return fmt.Errorf("big: невозможно прочитать %v", v)
+		}
+		b.set(newDecimalFromFloat(v, 64))
+	case nil:
+		return errors.New("big: невозможно прочитать NULL, используйте nullable.Type[big.Big]")
+	default:
+		return fmt.Errorf("big: неподдерживаемый тип %T для Scan", value)
+	}
+	return nil
+}
+
+// MarshalBinary реализует интерфейс encoding.BinaryMarshaler.
+// Формат: версия, тип, масштаб (varint, только для Decimal), знак и модуль в big-endian
+func (b Big) MarshalBinary() ([]byte, error) {
+	v := b.orZero()
+	x, scale := v.unscaled()
+
+	buf := []byte{binaryVersion, byte(v.typ)}
+	if v.typ == Decimal {
+		buf = binary.AppendVarint(buf, int64(scale))
+	}
+	sign := byte(0)
+	if x.Sign() < 0 {
+		sign = 1
+	}
+	buf = append(buf, sign)
+	return append(buf, x.Bytes()...), nil
+}
+
+// UnmarshalBinary реализует интерфейс encoding.BinaryUnmarshaler
+func (b *Big) UnmarshalBinary(data []byte) error {
+	if len(data) < 3 || data[0] != binaryVersion {
+		return errors.New("big: неверный двоичный формат")
+	}
+
+	typ, rest := Type(data[1]), data[2:]
+	var scale int64
+	switch typ {
+	case Integer:
+	case Decimal:
+		var n int
+		if scale, n = binary.Varint(rest); n <= 0 || scale < 0 || scale > math.MaxInt32 {
+			return errors.New("big: неверный масштаб в двоичном формате")
+		}
+		rest = rest[n:]
+		// Как и в Parse, масштаб не может превышать число цифр модуля больше чем
+		// на MaxExponent: иначе String развернет несколько байт в гигабайты нулей
+		// (байт модуля дает не больше трех десятичных цифр)
+		if scale > MaxExponent+3*int64(len(rest)) {
+			return fmt.Errorf("big: %w в двоичном формате", ErrExponentRange)
+		}
+	default:
+		return fmt.Errorf("big: неизвестный тип %d в двоичном формате", typ)
+	}
+	if len(rest) == 0 || rest[0] > 1 {
+		return errors.New("big: неверный знак в двоичном формате")
+	}
+
+	x := new(big.Int).SetBytes(rest[1:])
+	if rest[0] == 1 {
+		x.Neg(x)
+	}
+	if typ == Integer {
+		b.set(newIntegerFromBigInt(x))
+	} else {
+		b.set(newDecimalScaled(x, int32(scale)))
+	}
+	return nil
+}
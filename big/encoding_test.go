@@ -0,0 +1,190 @@
+package big
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	type payment struct {
+		Amount Big  `json:"amount"`
+		Fee    *Big `json:"fee"`
+	}
+
+	in := payment{Amount: *New("123456789012345678901234567890.50"), Fee: New(42)}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	if string(data) != `{"amount":123456789012345678901234567890.50,"fee":42}` {
+		t.Errorf("Unexpected JSON: %s", data)
+	}
+
+	var out payment
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if out.Amount.String() != in.Amount.String() || out.Amount.Type() != Decimal {
+		t.Errorf("Expected %s, got %s", &in.Amount, &out.Amount)
+	}
+	if out.Fee.String() != "42" || out.Fee.Type() != Integer {
+		t.Errorf("Expected Integer 42, got %s", out.Fee)
+	}
+}
+
+func TestStringJSON(t *testing.T) {
+	type payment struct {
+		Amount StringJSON `json:"amount"`
+		Fee    Big        `json:"fee"`
+	}
+
+	data, err := json.Marshal(payment{Amount: StringJSON(*New("0.10")), Fee: *New("0.5")})
+	if err != nil || string(data) != `{"amount":"0.10","fee":0.5}` {
+		t.Errorf(`Expected {"amount":"0.10","fee":0.5}, got %s, %v`, data, err)
+	}
+
+	var zero StringJSON
+	if data, err := json.Marshal(zero); err != nil || string(data) != `"0"` {
+		t.Errorf(`Expected "0", got %s, %v`, data, err)
+	}
+
+	var out payment
+	if err := json.Unmarshal([]byte(`{"amount":"-7.25","fee":"1"}`), &out); err != nil || out.Amount.String() != "-7.25" {
+		t.Errorf("Expected -7.25, got %s, %v", out.Amount, err)
+	}
+	if err := json.Unmarshal([]byte(`{"amount":3.5}`), &out); err != nil || !out.Amount.Big().Equals(New("3.5")) {
+		t.Errorf("Expected 3.5, got %s, %v", out.Amount, err)
+	}
+	if err := json.Unmarshal([]byte(`{"amount":"abc"}`), &out); err == nil {
+		t.Errorf("Expected error for invalid number")
+	}
+}
+
+func TestSQL(t *testing.T) {
+	v, err := New("99.990").Value()
+	if err != nil || v != "99.990" {
+		t.Errorf("Expected 99.990, got %v, %v", v, err)
+	}
+
+	var b Big
+	for _, src := range []any{"99.990", []byte("99.990")} {
+		if err := b.Scan(src); err != nil || b.String() != "99.990" {
+			t.Errorf("Scan(%v): got %s, %v", src, &b, err)
+		}
+	}
+	if err := b.Scan(int64(7)); err != nil || b.String() != "7" {
+		t.Errorf("Scan(7): got %s, %v", &b, err)
+	}
+	if err := b.Scan(nil); err == nil {
+		t.Errorf("Expected error for NULL")
+	}
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if err := b.Scan(f); err == nil {
+			t.Errorf("Expected error for Scan(%v)", f)
+		}
+	}
+}
+
+func TestTypeRoundTrip(t *testing.T) {
+	for _, in := range []*Big{New(3), New(1).Add(New(2)), New("-0.5"), New("12.50"), New(1).ToDecimal()} {
+		text, _ := in.MarshalText()
+		var fromText Big
+		if err := fromText.UnmarshalText(text); err != nil {
+			t.Fatalf("UnmarshalText(%s) error: %v", text, err)
+		}
+
+		data, _ := json.Marshal(in)
+		var fromJSON Big
+		if err := json.Unmarshal(data, &fromJSON); err != nil {
+			t.Fatalf("Unmarshal(%s) error: %v", data, err)
+		}
+
+		str, _ := json.Marshal(StringJSON(*in))
+		var fromString StringJSON
+		if err := json.Unmarshal(str, &fromString); err != nil {
+			t.Fatalf("Unmarshal(%s) error: %v", str, err)
+		}
+
+		value, _ := in.Value()
+		var fromSQL Big
+		if err := fromSQL.Scan(value); err != nil {
+			t.Fatalf("Scan(%v) error: %v", value, err)
+		}
+
+		for name, out := range map[string]*Big{"text": &fromText, "json": &fromJSON, "string json": fromString.Big(), "sql": &fromSQL} {
+			if out.String() != in.String() || out.Type() != in.Type() {
+				t.Errorf("%s: expected %s of type %d, got %s of type %d", name, in, in.Type(), out, out.Type())
+			}
+		}
+	}
+
+	if data, _ := json.Marshal(New(1).Add(New(2))); string(data) != "3e0" {
+		t.Errorf("Expected 3e0, got %s", data)
+	}
+	if text, _ := New(1).Add(New(2)).MarshalText(); string(text) != "3." {
+		t.Errorf("Expected 3., got %s", text)
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, s := range []string{"0", "-1", "18446744073709551616", "-0.000123", "1.50"} {
+		data, err := New(s).MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%s) error: %v", s, err)
+		}
+		var b Big
+		if err := b.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(%s) error: %v", s, err)
+		}
+		if b.String() != s || b.Type() != New(s).Type() {
+			t.Errorf("Expected %s, got %s", s, &b)
+		}
+	}
+
+	var b Big
+	if err := b.UnmarshalBinary([]byte{9, 0, 0}); err == nil {
+		t.Errorf("Expected error for unknown version")
+	}
+}
+
+func TestZeroValueEncoding(t *testing.T) {
+	var b Big
+	if text, err := b.MarshalText(); err != nil || string(text) != "0" {
+		t.Errorf("Expected 0, got %s, %v", text, err)
+	}
+}
+
+func TestDecodeHugeExponent(t *testing.T) {
+	start := time.Now()
+
+	var b Big
+	if err := json.Unmarshal([]byte(`"1e30000000"`), &b); !errors.Is(err, ErrExponentRange) {
+		t.Errorf("json string error = %v, want ErrExponentRange", err)
+	}
+	if err := json.Unmarshal([]byte(`1e-50000000`), &b); !errors.Is(err, ErrExponentRange) {
+		t.Errorf("json number error = %v, want ErrExponentRange", err)
+	}
+	if err := b.UnmarshalText([]byte("9e99999")); !errors.Is(err, ErrExponentRange) {
+		t.Errorf("UnmarshalText error = %v, want ErrExponentRange", err)
+	}
+	for _, src := range []any{"1e30000000", []byte("1e-30000000")} {
+		if err := b.Scan(src); !errors.Is(err, ErrExponentRange) {
+			t.Errorf("Scan(%v) error = %v, want ErrExponentRange", src, err)
+		}
+	}
+
+	// Масштаб 2^31-1 при модуле в один байт
+	data := binary.AppendVarint([]byte{binaryVersion, byte(Decimal)}, 1<<31-1)
+	data = append(data, 0, 1)
+	if err := b.UnmarshalBinary(data); !errors.Is(err, ErrExponentRange) {
+		t.Errorf("UnmarshalBinary error = %v, want ErrExponentRange", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("decoding huge exponents took %v", elapsed)
+	}
+}
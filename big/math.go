@@ -0,0 +1,461 @@
+package big
+
+import (
+	"math/big"
+	"strings"
+)
+
+// guardDigits - запас цифр промежуточных вычислений сверх запрошенной точности
+const guardDigits = 10
+
+// Exp возвращает e^b, округленное до ctx.Precision значащих цифр
+func (b *Big) Exp(ctx ...Context) *Big {
+	c := contextOf(ctx)
+	if b.IsZero() {
+		return newDecimalScaled(big.NewInt(1), 0)
+	}
+
+	x := b.rat()
+	neg := x.Sign() < 0
+	x.Abs(x)
+
+	// e^|x| >= 1, поэтому абсолютная точность 10^-w дает относительную
+	w := int32(c.Precision + guardDigits)
+	result := expFixed(x, w)
+
+	r := new(big.Rat).SetFrac(result, pow10(w))
+	if neg {
+		r.Inv(r)
+	}
+	return roundRat(r, c).stripZeros(0)
+}
+
+// Ln возвращает натуральный логарифм числа, округленный до ctx.Precision значащих цифр
+func (b *Big) Ln(ctx ...Context) *Big {
+	c := contextOf(ctx)
+	if b.Sign() <= 0 {
+		panic("логарифм неположительного числа")
+	}
+	if b.Compare(newInteger(1)) == 0 {
+		return newDecimalScaled(new(big.Int), 0)
+	}
+
+	w := int32(c.Precision+guardDigits) + leadingZeros(b.Subtract(newInteger(1)).rat())
+	return roundRat(new(big.Rat).SetFrac(lnFixed(b.rat(), w), pow10(w)), c).stripZeros(0)
+}
+
+// Log10 возвращает десятичный логарифм числа, округленный до ctx.Precision значащих цифр
+func (b *Big) Log10(ctx ...Context) *Big {
+	c := contextOf(ctx)
+	if b.Sign() <= 0 {
+		panic("логарифм неположительного числа")
+	}
+
+	// Точные степени десяти дают точный результат
+	x, scale := b.unscaled()
+	if s := x.String(); s[0] == '1' && strings.Trim(s[1:], "0") == "" {
+		return newDecimalScaled(big.NewInt(int64(len(s)-1)-int64(scale)), 0)
+	}
+
+	w := int32(c.Precision+guardDigits) + leadingZeros(b.Subtract(newInteger(1)).rat())
+	ln := lnFixed(b.rat(), w)
+	ln10 := lnFixed(new(big.Rat).SetInt64(10), w)
+	return roundRat(new(big.Rat).SetFrac(ln, ln10), c).stripZeros(0)
+}
+
+// PowDecimal возвращает b^exponent для произвольного показателя.
+// Целые показатели вычисляются точно (с округлением до ctx.Precision),
+// дробные требуют положительного основания и вычисляются как e^(exponent*ln b)
+func (b *Big) PowDecimal(exponent *Big, ctx ...Context) *Big {
+	c := contextOf(ctx)
+	if n, ok := exactInteger(exponent); ok && n.IsInt64() {
+		if n.Sign() >= 0 {
+			return b.Pow(n.Int64()).Round(c)
+		}
+		return newInteger(1).DivideContext(b.Pow(-n.Int64()), c)
+	}
+	if b.Sign() < 0 {
+		panic("дробная степень отрицательного числа")
+	}
+	if b.IsZero() {
+		if exponent.Sign() < 0 {
+			panic("деление на ноль")
+		}
+		return newDecimalScaled(new(big.Int), 0)
+	}
+
+	// Погрешность ln b умножается на |exponent * ln b|, поэтому добавляем его порядок к точности
+	estimate := exponent.Multiply(b.Ln(Context{Precision: 20}))
+	extra := max(digitCount(estimate.ToInteger()), 1)
+	work := Context{Precision: c.Precision + guardDigits + extra, Rounding: HalfEven}
+	return exponent.Multiply(b.Ln(work)).Exp(work).Round(c).stripZeros(0)
+}
+
+// Sin возвращает синус числа (в радианах), округленный до ctx.Precision значащих цифр
+func (b *Big) Sin(ctx ...Context) *Big {
+	sin, _ := b.sinCos(contextOf(ctx), true)
+	return sin
+}
+
+// Cos возвращает косинус числа (в радианах), округленный до ctx.Precision значащих цифр
+func (b *Big) Cos(ctx ...Context) *Big {
+	_, cos := b.sinCos(contextOf(ctx), false)
+	return cos
+}
+
+// Atan возвращает арктангенс числа в радианах, округленный до ctx.Precision значащих цифр
+func (b *Big) Atan(ctx ...Context) *Big {
+	c := contextOf(ctx)
+	if b.IsZero() {
+		return newDecimalScaled(new(big.Int), 0)
+	}
+
+	x := b.rat()
+	w := int32(c.Precision+guardDigits) + leadingZeros(x)
+	return roundRat(new(big.Rat).SetFrac(atanFixed(x, w), pow10(w)), c).stripZeros(0)
+}
+
+// Pi возвращает число π, округленное до ctx.Precision значащих цифр
+func Pi(ctx ...Context) *Big {
+	c := contextOf(ctx)
+	w := int32(c.Precision + guardDigits)
+	return roundRat(new(big.Rat).SetFrac(piFixed(w), pow10(w)), c)
+}
+
+// E возвращает число e, округленное до ctx.Precision значащих цифр
+func E(ctx ...Context) *Big {
+	return newInteger(1).Exp(ctx...)
+}
+
+// GCD возвращает наибольший общий делитель двух целых чисел
+func (b *Big) GCD(other *Big) *Big {
+	x, y := mustInteger(b), mustInteger(other)
+	return newIntegerFromBigInt(new(big.Int).GCD(nil, nil, x.Abs(x), y.Abs(y)))
+}
+
+// LCM возвращает наименьшее общее кратное двух целых чисел
+func (b *Big) LCM(other *Big) *Big {
+	x, y := mustInteger(b), mustInteger(other)
+	if x.Sign() == 0 || y.Sign() == 0 {
+		return newInteger(0)
+	}
+	x.Abs(x)
+	y.Abs(y)
+	gcd := new(big.Int).GCD(nil, nil, x, y)
+	return newIntegerFromBigInt(x.Mul(x.Quo(x, gcd), y))
+}
+
+// ModPow возвращает b^exponent mod modulus для целых чисел
+func (b *Big) ModPow(exponent, modulus *Big) *Big {
+	x, e, m := mustInteger(b), mustInteger(exponent), mustInteger(modulus)
+	if m.Sign() == 0 {
+		panic("деление на ноль")
+	}
+	if e.Sign() < 0 {
+		inverse, ok := b.ModInverse(modulus)
+		if !ok {
+			panic("обратный элемент по модулю не существует")
+		}
+		x = inverse.int
+		e.Neg(e)
+	}
+	return newIntegerFromBigInt(new(big.Int).Exp(x, e, new(big.Int).Abs(m)))
+}
+
+// ModInverse возвращает x такое, что b*x ≡ 1 (mod modulus).
+// Второй результат ложен, если b и modulus не взаимно просты
+func (b *Big) ModInverse(modulus *Big) (*Big, bool) {
+	x, m := mustInteger(b), mustInteger(modulus)
+	if m.Sign() == 0 {
+		panic("деление на ноль")
+	}
+	m.Abs(m)
+	inverse := new(big.Int).ModInverse(x.Mod(x, m), m)
+	if inverse == nil {
+		return nil, false
+	}
+	return newIntegerFromBigInt(inverse), true
+}
+
+// ProbablyPrime сообщает, является ли целое число простым.
+// Проверка Миллера-Рабина с rounds раундами и тест Бэйли-PSW,
+// для чисел меньше 2^64 результат точен
+func (b *Big) ProbablyPrime(rounds int) bool {
+	x, ok := exactInteger(b)
+	return ok && x.ProbablyPrime(rounds)
+}
+
+// Factorial возвращает n!
+func Factorial(n int64) *Big {
+	if n < 0 {
+		panic("факториал отрицательного числа")
+	}
+	return newIntegerFromBigInt(new(big.Int).MulRange(1, n))
+}
+
+// Binomial возвращает биномиальный коэффициент C(n, k)
+func Binomial(n, k int64) *Big {
+	if k < 0 || n < 0 || k > n {
+		return newInteger(0)
+	}
+	return newIntegerFromBigInt(new(big.Int).Binomial(n, k))
+}
+
+// sinCos вычисляет синус или косинус с приведением аргумента к [-π/4, π/4]
+func (b *Big) sinCos(c Context, wantSin bool) (*Big, *Big) {
+	x := b.rat()
+	if x.Sign() == 0 {
+		if wantSin {
+			return newDecimalScaled(new(big.Int), 0), nil
+		}
+		return nil, newDecimalScaled(big.NewInt(1), 0)
+	}
+
+	// Для приведения по модулю π/2 нужна точность π, покрывающая целую часть аргумента
+	intDigits := int32(digitCount(new(big.Int).Quo(x.Num(), x.Denom())))
+	w := int32(c.Precision+guardDigits) + leadingZeros(x)
+	wPi := w + intDigits
+	one := pow10(wPi)
+	halfPi := new(big.Int).Rsh(piFixed(wPi), 1)
+
+	xf := ratToFixed(x, wPi)
+	n := new(big.Int).Mul(xf, big.NewInt(2))
+	n.Add(n, halfPi).Quo(n, new(big.Int).Lsh(halfPi, 1))
+	if xf.Sign() < 0 {
+		// Округление к ближайшему для отрицательных аргументов
+		n.Mul(xf, big.NewInt(2)).Sub(n, halfPi).Quo(n, new(big.Int).Lsh(halfPi, 1))
+	}
+	r := new(big.Int).Sub(xf, new(big.Int).Mul(n, halfPi))
+
+	s, co := sinCosFixed(r, one)
+	switch new(big.Int).Mod(n, big.NewInt(4)).Int64() {
+	case 1:
+		s, co = co, s.Neg(s)
+	case 2:
+		s, co = s.Neg(s), co.Neg(co)
+	case 3:
+		s, co = co.Neg(co), s
+	}
+
+	den := pow10(wPi)
+	if wantSin {
+		return roundRat(new(big.Rat).SetFrac(s, den), c).stripZeros(0), nil
+	}
+	return nil, roundRat(new(big.Rat).SetFrac(co, den), c).stripZeros(0)
+}
+
+// sinCosFixed вычисляет синус и косинус малого аргумента в фиксированной точке рядом Тейлора
+func sinCosFixed(x, one *big.Int) (*big.Int, *big.Int) {
+	x2 := fixedMul(x, x, one)
+	sin, cos := new(big.Int), new(big.Int)
+
+	term := new(big.Int).Set(x)
+	for n := int64(1); term.Sign() != 0; n += 2 {
+		sin.Add(sin, term)
+		term = fixedMul(term, x2, one)
+		term.Quo(term, big.NewInt(-(n+1)*(n+2)))
+	}
+
+	term = new(big.Int).Set(one)
+	for n := int64(0); term.Sign() != 0; n += 2 {
+		cos.Add(cos, term)
+		term = fixedMul(term, x2, one)
+		term.Quo(term, big.NewInt(-(n+1)*(n+2)))
+	}
+	return sin, cos
+}
+
+// expFixed вычисляет e^x для x >= 0 в фиксированной точке с w знаками
+func expFixed(x *big.Rat, w int32) *big.Int {
+	// Делим аргумент на 2^k, чтобы он стал меньше 1/8, а результат возводим в квадрат k раз.
+	// Каждое возведение удваивает относительную погрешность, поэтому добавляем k/3 цифр
+	k := max(new(big.Int).Quo(x.Num(), x.Denom()).BitLen()+3, 0)
+	w += int32(k/3 + 1)
+	one := pow10(w)
+
+	r := ratToFixed(new(big.Rat).Quo(x, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(k)))), w)
+
+	sum := new(big.Int)
+	term := new(big.Int).Set(one)
+	for n := int64(1); term.Sign() != 0; n++ {
+		sum.Add(sum, term)
+		term = fixedMul(term, r, one)
+		term.Quo(term, big.NewInt(n))
+	}
+	for range k {
+		sum = fixedMul(sum, sum, one)
+	}
+
+	// Возвращаем результат с исходным количеством знаков
+	return sum.Quo(sum, pow10(int32(k/3+1)))
+}
+
+// lnFixed вычисляет ln x для x > 0 в фиксированной точке с w знаками.
+// x = m * 2^k с m в [2/3, 4/3], ln x = 2 atanh((m-1)/(m+1)) + k ln 2
+func lnFixed(x *big.Rat, w int32) *big.Int {
+	w += guardDigits
+	one := pow10(w)
+
+	k := x.Num().BitLen() - x.Denom().BitLen()
+	m := new(big.Rat).Set(x)
+	shift := new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(max(k, -k))))
+	if k > 0 {
+		m.Quo(m, shift)
+	} else if k < 0 {
+		m.Mul(m, shift)
+	}
+	two := big.NewRat(2, 1)
+	for m.Cmp(big.NewRat(4, 3)) > 0 {
+		m.Quo(m, two)
+		k++
+	}
+	for m.Cmp(big.NewRat(2, 3)) < 0 {
+		m.Mul(m, two)
+		k--
+	}
+
+	z := new(big.Rat).Sub(m, big.NewRat(1, 1))
+	z.Quo(z, new(big.Rat).Add(m, big.NewRat(1, 1)))
+	result := atanhFixed(ratToFixed(z, w), one)
+	result.Lsh(result, 1)
+
+	if k != 0 {
+		ln2 := atanhFixed(ratToFixed(big.NewRat(1, 3), w), one)
+		ln2.Lsh(ln2, 1)
+		result.Add(result, ln2.Mul(ln2, big.NewInt(int64(k))))
+	}
+	return result.Quo(result, pow10(guardDigits))
+}
+
+// atanhFixed вычисляет atanh(z) = z + z^3/3 + z^5/5 + ... в фиксированной точке
+func atanhFixed(z, one *big.Int) *big.Int {
+	z2 := fixedMul(z, z, one)
+	sum := new(big.Int)
+	power := new(big.Int).Set(z)
+	for n := int64(1); power.Sign() != 0; n += 2 {
+		sum.Add(sum, new(big.Int).Quo(power, big.NewInt(n)))
+		power = fixedMul(power, z2, one)
+	}
+	return sum
+}
+
+// atanFixed вычисляет arctg x в фиксированной точке с w знаками
+func atanFixed(x *big.Rat, w int32) *big.Int {
+	w += guardDigits
+	one := pow10(w)
+
+	neg := x.Sign() < 0
+	a := new(big.Rat).Abs(x)
+	inverted := a.Cmp(big.NewRat(1, 1)) > 0
+	if inverted {
+		// atan(x) = π/2 - atan(1/x)
+		a.Inv(a)
+	}
+
+	// Уменьшаем аргумент: atan(a) = 2 atan(a / (1 + sqrt(1 + a^2)))
+	af := ratToFixed(a, w)
+	halvings := uint(0)
+	limit := new(big.Int).Quo(one, big.NewInt(10))
+	for af.Cmp(limit) > 0 {
+		root := new(big.Int).Add(fixedMul(af, af, one), one)
+		root.Sqrt(root.Mul(root, one))
+		af.Mul(af, one).Quo(af, root.Add(root, one))
+		halvings++
+	}
+
+	af2 := fixedMul(af, af, one)
+	sum := new(big.Int)
+	power := new(big.Int).Set(af)
+	for n := int64(1); power.Sign() != 0; n += 2 {
+		term := new(big.Int).Quo(power, big.NewInt(n))
+		if n%4 == 1 {
+			sum.Add(sum, term)
+		} else {
+			sum.Sub(sum, term)
+		}
+		power = fixedMul(power, af2, one)
+	}
+	sum.Lsh(sum, halvings)
+
+	if inverted {
+		halfPi := new(big.Int).Rsh(piFixed(w), 1)
+		sum.Sub(halfPi, sum)
+	}
+	if neg {
+		sum.Neg(sum)
+	}
+	return sum.Quo(sum, pow10(guardDigits))
+}
+
+// piFixed вычисляет π по формуле Мэчина: π = 16 atan(1/5) - 4 atan(1/239)
+func piFixed(w int32) *big.Int {
+	w += guardDigits
+	one := pow10(w)
+
+	pi := new(big.Int).Mul(atanInvFixed(5, one), big.NewInt(16))
+	pi.Sub(pi, new(big.Int).Mul(atanInvFixed(239, one), big.NewInt(4)))
+	return pi.Quo(pi, pow10(guardDigits))
+}
+
+// atanInvFixed вычисляет atan(1/n) в фиксированной точке
+func atanInvFixed(n int64, one *big.Int) *big.Int {
+	n2 := big.NewInt(n * n)
+	sum := new(big.Int)
+	power := new(big.Int).Quo(one, big.NewInt(n))
+	for k := int64(1); power.Sign() != 0; k += 2 {
+		term := new(big.Int).Quo(power, big.NewInt(k))
+		if k%4 == 1 {
+			sum.Add(sum, term)
+		} else {
+			sum.Sub(sum, term)
+		}
+		power.Quo(power, n2)
+	}
+	return sum
+}
+
+// fixedMul перемножает два числа в фиксированной точке с единицей one
+func fixedMul(a, b, one *big.Int) *big.Int {
+	result := new(big.Int).Mul(a, b)
+	return result.Quo(result, one)
+}
+
+// ratToFixed переводит дробь в фиксированную точку с w знаками (с усечением)
+func ratToFixed(r *big.Rat, w int32) *big.Int {
+	result := new(big.Int).Mul(r.Num(), pow10(w))
+	return result.Quo(result, r.Denom())
+}
+
+// leadingZeros возвращает количество нулей после запятой до первой значащей цифры |r|
+func leadingZeros(r *big.Rat) int32 {
+	if r.Sign() == 0 {
+		return 0
+	}
+	num, den := new(big.Int).Abs(r.Num()), r.Denom()
+	if num.Cmp(den) >= 0 {
+		return 0
+	}
+	return int32(digitCount(den) - digitCount(num))
+}
+
+// rat возвращает точное значение числа в виде дроби
+func (b *Big) rat() *big.Rat {
+	x, scale := b.unscaled()
+	return new(big.Rat).SetFrac(x, pow10(scale))
+}
+
+// exactInteger возвращает значение числа, если оно целое
+func exactInteger(b *Big) (*big.Int, bool) {
+	x, scale := b.unscaled()
+	q, r := new(big.Int).QuoRem(x, pow10(scale), new(big.Int))
+	return q, r.Sign() == 0
+}
+
+// mustInteger возвращает значение целого числа или паникует для дробного
+func mustInteger(b *Big) *big.Int {
+	x, ok := exactInteger(b)
+	if !ok {
+		panic("ожидалось целое число")
+	}
+	return x
+}
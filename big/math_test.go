@@ -0,0 +1,85 @@
+package big
+
+import (
+	"testing"
+)
+
+func TestConstants(t *testing.T) {
+	ctx := Context{Precision: 30}
+	if got := Pi(ctx).String(); got != "3.14159265358979323846264338328" {
+		t.Errorf("Pi = %s", got)
+	}
+	if got := E(ctx).String(); got != "2.71828182845904523536028747135" {
+		t.Errorf("E = %s", got)
+	}
+	if got := Pi(Context{Precision: 3}).String(); got != "3.14" {
+		t.Errorf("Pi(3) = %s", got)
+	}
+}
+
+func TestTranscendental(t *testing.T) {
+	ctx := Context{Precision: 30}
+	tests := []struct {
+		name string
+		got  *Big
+		want string
+	}{
+		{"Exp(100)", New(100).Exp(ctx), "26881171418161354484126255515800000000000000"},
+		{"Exp(-2)", New(-2).Exp(ctx), "0.135335283236612691893999494972"},
+		{"Ln(2)", New(2).Ln(ctx), "0.693147180559945309417232121458"},
+		{"Ln(0.5)", New("0.5").Ln(ctx), "-0.693147180559945309417232121458"},
+		{"Ln(1.0000001)", New("1.0000001").Ln(ctx), "0.0000000999999950000003333333083333353"},
+		{"Log10(1000)", New(1000).Log10(), "3"},
+		{"Log10(0.01)", New("0.01").Log10(), "-2"},
+		{"Log10(2)", New(2).Log10(ctx), "0.301029995663981195213738894724"},
+		{"Sin(1)", New(1).Sin(ctx), "0.84147098480789650665250232163"},
+		{"Sin(-1)", New(-1).Sin(ctx), "-0.84147098480789650665250232163"},
+		{"Sin(100)", New(100).Sin(ctx), "-0.50636564110975879365655761046"},
+		{"Cos(1)", New(1).Cos(ctx), "0.540302305868139717400936607443"},
+		{"Atan(1)", New(1).Atan(ctx), "0.78539816339744830961566084582"},
+		{"Atan(-3)", New(-3).Atan(ctx), "-1.24904577239825442582991707728"},
+		{"2^0.5", New(2).PowDecimal(New("0.5"), ctx), "1.41421356237309504880168872421"},
+		{"10^-2", New(10).PowDecimal(New(-2)), "0.01"},
+	}
+	for _, tt := range tests {
+		if tt.got.String() != tt.want {
+			t.Errorf("%s = %s, expected %s", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestIntegerFunctions(t *testing.T) {
+	if got := New(12).GCD(New(-18)).String(); got != "6" {
+		t.Errorf("GCD = %s", got)
+	}
+	if got := New(4).LCM(New(6)).String(); got != "12" {
+		t.Errorf("LCM = %s", got)
+	}
+	if got := New(4).ModPow(New(13), New(497)).String(); got != "445" {
+		t.Errorf("ModPow = %s", got)
+	}
+	if inv, ok := New(3).ModInverse(New(11)); !ok || inv.String() != "4" {
+		t.Errorf("ModInverse = %v, %v", inv, ok)
+	}
+	if _, ok := New(4).ModInverse(New(8)); ok {
+		t.Errorf("Expected no inverse for 4 mod 8")
+	}
+	if !New("170141183460469231731687303715884105727").ProbablyPrime(20) || New(91).ProbablyPrime(20) {
+		t.Errorf("ProbablyPrime returned wrong result")
+	}
+	if got := Factorial(25).String(); got != "15511210043330985984000000" {
+		t.Errorf("Factorial(25) = %s", got)
+	}
+	if got := Binomial(50, 25).String(); got != "126410606437752" {
+		t.Errorf("Binomial(50, 25) = %s", got)
+	}
+}
+
+func TestIntegerFunctionsRejectFractions(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected panic for fractional GCD")
+		}
+	}()
+	New("1.5").GCD(New(3))
+}
@@ -0,0 +1,211 @@
+package cast
+
+import (
+	"fmt"
+	mathbig "math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"types/big"
+	"types/rational"
+	"types/uuid"
+)
+
+var (
+	uuidType     = reflect.TypeFor[uuid.UUID]()
+	bigType      = reflect.TypeFor[big.Big]()
+	bigPtrType   = reflect.TypeFor[*big.Big]()
+	ratType      = reflect.TypeFor[rational.Rational]()
+	ratPtrType   = reflect.TypeFor[*rational.Rational]()
+	mathRatType  = reflect.TypeFor[*mathbig.Rat]()
+	mathIntType  = reflect.TypeFor[*mathbig.Int]()
+	bytesType    = reflect.TypeFor[[]byte]()
+	nullSetNames = []string{"SetNull", "SetNone"}
+)
+
+// convertBuiltin выполняет преобразования для типов, о которых знает пакет:
+// time.Time, time.Duration, uuid.UUID, big.Big, rational.Rational и контейнеров
+// вида nullable.Type[T] и option.Option[T]. Второй результат сообщает,
+// относится ли пара типов к этим преобразованиям
+func (c conversion) convertBuiltin(sv reflect.Value, targetType reflect.Type) (any, bool, error) {
+	sourceType := sv.Type()
+
+	switch {
+	case targetType == timeType:
+		t, err := c.toTime(sv)
+		return t, true, err
+	case targetType == durationType:
+		d, err := c.toDuration(sv)
+		return d, true, err
+	case sourceType == timeType && (isNumberKind(targetType.Kind()) || targetType.Kind() == reflect.String):
+		result, err := c.fromTime(sv.Interface().(time.Time), targetType)
+		return result, true, err
+	case sourceType == durationType && isNumberKind(targetType.Kind()) && c.opts.DurationUnit > 0:
+		result, err := c.fromDuration(time.Duration(sv.Int()), targetType)
+		return result, true, err
+	case targetType == uuidType:
+		id, err := toUUID(sv)
+		return id, true, err
+	case targetType == bigPtrType || targetType == bigType:
+		b, err := c.toBig(sv)
+		if err != nil || targetType == bigPtrType {
+			return b, true, err
+		}
+		return *b, true, nil
+	case targetType == ratPtrType || targetType == ratType:
+		r, err := c.toRational(sv)
+		if err != nil || targetType == ratPtrType {
+			return r, true, err
+		}
+		return *r, true, nil
+	}
+
+	return c.convertContainer(sv, targetType)
+}
+
+// toUUID разбирает UUID из строки или 16 байт
+func toUUID(sv reflect.Value) (uuid.UUID, error) {
+	var s string
+	switch {
+	case sv.Kind() == reflect.String:
+		s = sv.String()
+	case sv.Type().ConvertibleTo(bytesType):
+		b := sv.Convert(bytesType).Bytes()
+		if len(b) == len(uuid.UUID{}) {
+			var id uuid.UUID
+			copy(id[:], b)
+			return id, nil
+		}
+		s = string(b)
+	default:
+		return uuid.Nil, &ConversionError{Value: sv.Interface(), Target: uuidType, Err: ErrFormat}
+	}
+
+	id, err := uuid.FromString(strings.TrimSpace(s))
+	if err != nil {
+		return uuid.Nil, &ConversionError{Value: sv.Interface(), Target: uuidType, Err: fmt.Errorf("%w: %w", ErrFormat, err)}
+	}
+	return id, nil
+}
+
+// toBig преобразует число или строку в *big.Big
+func (c conversion) toBig(sv reflect.Value) (*big.Big, error) {
+	fail := func(err error) (*big.Big, error) {
+		return nil, &ConversionError{Value: sv.Interface(), Target: bigPtrType, Err: err}
+	}
+
+	switch {
+	case isSignedKind(sv.Kind()):
+		return big.New(sv.Int()), nil
+	case isFloatKind(sv.Kind()):
+		return big.New(sv.Float()), nil
+	case isNumberKind(sv.Kind()):
+		return big.New(*new(mathbig.Int).SetUint64(sv.Uint())), nil
+	case sv.Type() == mathIntType:
+		return big.New(*sv.Interface().(*mathbig.Int)), nil
+	case sv.Kind() == reflect.String:
+		s := strings.TrimSpace(sv.String())
+		if c.opts.Mode == Lenient {
+			r, err := parseLenientNumber(s)
+			if err != nil {
+				return fail(err)
+			}
+			s = r.RatString()
+			if !r.IsInt() {
+				s = r.FloatString(decimalDigits(r))
+			}
+		}
+		if _, ok := new(mathbig.Float).SetString(s); !ok {
+			return fail(fmt.Errorf("%w: %q не является числом", ErrFormat, s))
+		}
+		return big.New(s), nil
+	default:
+		return fail(ErrFormat)
+	}
+}
+
+// toRational преобразует число или строку ("3/4", "-1.25", "1e-3", "0.(3)") в *rational.Rational без потери точности
+func (c conversion) toRational(sv reflect.Value) (*rational.Rational, error) {
+	fail := func(err error) (*rational.Rational, error) {
+		return nil, &ConversionError{Value: sv.Interface(), Target: ratPtrType, Err: err}
+	}
+
+	var r *mathbig.Rat
+	switch {
+	case sv.Type() == mathRatType:
+		r = sv.Interface().(*mathbig.Rat)
+	case sv.Kind() == reflect.String && c.opts.Mode != Lenient:
+		parsed, err := rational.Parse(sv.String())
+		if err != nil {
+			return fail(fmt.Errorf("%w: %w", ErrFormat, err))
+		}
+		return parsed, nil
+	default:
+		if isFloatKind(sv.Kind()) && !isFinite(sv.Float()) {
+			return fail(ErrOverflow)
+		}
+		var err error
+		if r, err = toRat(sv, c.opts.Mode); err != nil {
+			return fail(err)
+		}
+	}
+	return rational.NewFromBigInt(r.Num(), r.Denom()), nil
+}
+
+// convertContainer заполняет обобщенные контейнеры, распознавая их по методам
+// Set(T) и SetNull()/SetNone(). nil и пустая строка (если T не строка) дают
+// пустой контейнер, остальные значения преобразуются в T
+func (c conversion) convertContainer(sv reflect.Value, targetType reflect.Type) (any, bool, error) {
+	ptrType := reflect.PointerTo(targetType)
+
+	set, ok := ptrType.MethodByName("Set")
+	if !ok || set.Type.NumIn() != 2 || set.Type.NumOut() != 0 {
+		return nil, false, nil
+	}
+	var setNull reflect.Method
+	for _, name := range nullSetNames {
+		if setNull, ok = ptrType.MethodByName(name); ok && setNull.Type.NumIn() == 1 {
+			break
+		}
+	}
+	if !ok {
+		return nil, false, nil
+	}
+
+	elemType := set.Type.In(1)
+	result := reflect.New(targetType)
+
+	if sv.Kind() == reflect.String && sv.Len() == 0 && elemType.Kind() != reflect.String {
+		setNull.Func.Call([]reflect.Value{result})
+		return result.Elem().Interface(), true, nil
+	}
+
+	value, err := c.convert(sv.Interface(), elemType)
+	if err != nil {
+		return nil, true, err
+	}
+	elem := reflect.New(elemType).Elem()
+	if value != nil {
+		elem.Set(reflect.ValueOf(value))
+	}
+	set.Func.Call([]reflect.Value{result, elem})
+	return result.Elem().Interface(), true, nil
+}
+
+// decimalDigits возвращает количество знаков после запятой, достаточное
+// для точной записи дроби с конечным десятичным представлением
+func decimalDigits(r *mathbig.Rat) int {
+	den := new(mathbig.Int).Set(r.Denom())
+	digits := 0
+	ten := mathbig.NewInt(10)
+	for den.Cmp(mathbig.NewInt(1)) > 0 && digits < 100 {
+		den.Quo(den, mathbig.NewInt(1).GCD(nil, nil, den, ten))
+		digits++
+	}
+	return digits
+}
+
+func isFinite(f float64) bool {
+	return f-f == 0
+}
@@ -0,0 +1,115 @@
+package cast
+
+import (
+	"errors"
+	"testing"
+
+	"types/big"
+	"types/option"
+	"types/rational"
+	"types/uuid"
+)
+
+func TestTo_UUID(t *testing.T) {
+	want, _ := uuid.FromString("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+
+	got, err := To[uuid.UUID]("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	if err != nil || got != want {
+		t.Errorf("ожидалось %v, получено %v, %v", want, got, err)
+	}
+
+	got, err = To[uuid.UUID](want.Bytes())
+	if err != nil || got != want {
+		t.Errorf("ожидалось %v, получено %v, %v", want, got, err)
+	}
+
+	s, err := To[string](want)
+	if err != nil || s != "f47ac10b-58cc-4372-a567-0e02b2c3d479" {
+		t.Errorf("получено %q, %v", s, err)
+	}
+
+	if _, err := To[uuid.UUID]("not-a-uuid"); !errors.Is(err, ErrFormat) {
+		t.Errorf("ожидалась ErrFormat, получено %v", err)
+	}
+}
+
+func TestTo_Big(t *testing.T) {
+	b, err := To[*big.Big]("123456789012345678901234567890")
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if b.String() != "123456789012345678901234567890" {
+		t.Errorf("получено %s", b)
+	}
+
+	b, err = To[*big.Big](uint64(18446744073709551615))
+	if err != nil || b.String() != "18446744073709551615" {
+		t.Errorf("получено %v, %v", b, err)
+	}
+
+	if _, err := To[*big.Big]("abc"); !errors.Is(err, ErrFormat) {
+		t.Errorf("ожидалась ErrFormat, получено %v", err)
+	}
+}
+
+func TestTo_Rational(t *testing.T) {
+	tests := []struct {
+		input any
+		want  *rational.Rational
+	}{
+		{"3/4", rational.New(3, 4)},
+		{"-1.25", rational.New(-5, 4)},
+		{"1e-3", rational.New(1, 1000)},
+		{"0.(142857)", rational.New(1, 7)},
+		{0.5, rational.New(1, 2)},
+		{7, rational.NewFromInt(7)},
+	}
+
+	for _, tt := range tests {
+		got, err := To[*rational.Rational](tt.input)
+		if err != nil {
+			t.Fatalf("ошибка для %v: %v", tt.input, err)
+		}
+		if !got.Equals(tt.want) {
+			t.Errorf("для %v ожидалось %s, получено %s", tt.input, tt.want, got)
+		}
+	}
+}
+
+func TestTo_Option(t *testing.T) {
+	some, err := ToWithOptions[option.Option[int]]("42", Options{Mode: Strict})
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if v, ok := some.Get(); !ok || v != 42 {
+		t.Errorf("ожидалось Some(42), получено %v", some)
+	}
+
+	for _, input := range []any{nil, ""} {
+		none, err := To[option.Option[int]](input)
+		if err != nil || none.IsSome() {
+			t.Errorf("для %#v ожидалось None, получено %v, %v", input, none, err)
+		}
+	}
+
+	// Для строкового контейнера пустая строка остается значением
+	empty, err := To[option.Option[string]]("")
+	if err != nil || empty.IsNone() {
+		t.Errorf("ожидалось Some(\"\"), получено %v, %v", empty, err)
+	}
+}
+
+func TestTo_OptionField(t *testing.T) {
+	type profile struct {
+		Age  option.Option[int]    `json:"age"`
+		Nick option.Option[string] `json:"nick"`
+	}
+
+	got, err := To[profile](map[string]any{"age": float64(30)})
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if got.Age.GetOrElse(0) != 30 || got.Nick.IsSome() {
+		t.Errorf("получено %+v", got)
+	}
+}
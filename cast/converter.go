@@ -29,78 +29,15 @@ func (cf ConvertFunc) Convert(source any) (any, error) {
 }
 
 // To преобразует значение в целевой тип To
-// Использует встроенные преобразования или JSON маршалинг/анмаршалинг
+// Использует зарегистрированные конвертеры, встроенные преобразования или JSON маршалинг/анмаршалинг
 // Тип источника определяется динамически в runtime
 func To[T any](value any) (T, error) {
-	var zero T
-
-	// Если значение nil, возвращаем нулевое значение
-	if value == nil {
-		return zero, nil
-	}
-
-	// Получаем тип источника и целевого типа
-	fromType := reflect.TypeOf(value)
-	toType := reflect.TypeOf(zero)
-
-	// Если типы одинаковые, просто конвертируем
-	if fromType == toType {
-		return value.(T), nil
-	}
-
-	// Пытаемся преобразовать через reflect, если возможно
-	if fromType != nil && fromType.ConvertibleTo(toType) {
-		fromVal := reflect.ValueOf(value)
-		return fromVal.Convert(toType).Interface().(T), nil
-	}
-
-	// Пытаемся преобразовать через JSON
-	jsonData, err := json.Marshal(value)
-	if err != nil {
-		return zero, fmt.Errorf(jsonMarshalErrMsg, err)
-	}
-
-	var result T
-	err = json.Unmarshal(jsonData, &result)
-	if err != nil {
-		return zero, fmt.Errorf(jsonUnmarshalErrMsg, err)
-	}
-
-	return result, nil
+	return ToWith[T](defaultRegistry, value)
 }
 
-// ConvertTo преобразует значение к целевому типу с использованием reflect
+// ConvertTo преобразует значение к целевому типу с использованием глобального реестра
 func ConvertTo(source any, targetType reflect.Type) (any, error) {
-	if source == nil {
-		return reflect.Zero(targetType).Interface(), nil
-	}
-
-	sourceValue := reflect.ValueOf(source)
-	sourceType := sourceValue.Type()
-
-	// Если типы совпадают
-	if sourceType == targetType {
-		return source, nil
-	}
-
-	// Попытка прямого преобразования через reflect
-	if sourceType.ConvertibleTo(targetType) {
-		return sourceValue.Convert(targetType).Interface(), nil
-	}
-
-	// Преобразование через JSON
-	jsonData, err := json.Marshal(source)
-	if err != nil {
-		return nil, fmt.Errorf(jsonMarshalErrMsg, err)
-	}
-
-	result := reflect.New(targetType)
-	err = json.Unmarshal(jsonData, result.Interface())
-	if err != nil {
-		return nil, fmt.Errorf(jsonUnmarshalErrMsg, err)
-	}
-
-	return result.Elem().Interface(), nil
+	return defaultRegistry.ConvertTo(source, targetType)
 }
 
 // StringConverter преобразует любое значение в строку
@@ -131,14 +68,26 @@ func (sc StringConverter) Convert(source any) (any, error) {
 	}
 }
 
-// NumberConverter преобразует значения в числовой формат (float64)
-type NumberConverter struct{}
+// NumberConverter преобразует значения в числовой формат (float64).
+// В режимах Strict и Lenient строки разбираются по правилам режима,
+// а потеря точности возвращается как ошибка
+type NumberConverter struct {
+	Mode Mode
+}
 
 func (nc NumberConverter) Convert(source any) (any, error) {
 	if source == nil {
 		return float64(0), nil
 	}
 
+	if nc.Mode != Default {
+		result, err := convertNumber(reflect.ValueOf(source), float64Type, nc.Mode)
+		if err != nil {
+			return nil, err
+		}
+		return result.Interface(), nil
+	}
+
 	switch v := source.(type) {
 	case float64:
 		return v, nil
@@ -167,7 +116,7 @@ func (nc NumberConverter) Convert(source any) (any, error) {
 	case string:
 		num, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
 		if err != nil {
-			return nil, fmt.Errorf("невозможно преобразовать строку в число: %w", err)
+			return nil, &ConversionError{Value: v, Target: float64Type, Err: fmt.Errorf("%w: %w", ErrFormat, err)}
 		}
 		return num, nil
 	case bool:
@@ -180,33 +129,61 @@ func (nc NumberConverter) Convert(source any) (any, error) {
 	}
 }
 
-// BoolConverter преобразует значения в булев формат
-type BoolConverter struct{}
+// BoolConverter преобразует значения в булев формат.
+// В режиме Strict принимаются только "true", "false", "1", "0" и числа 0 и 1,
+// в режиме Lenient дополнительно "y", "n", "t" и "f"
+type BoolConverter struct {
+	Mode Mode
+}
 
 func (bc BoolConverter) Convert(source any) (any, error) {
 	if source == nil {
 		return false, nil
 	}
 
+	// Во всех режимах ошибка одного типа: *ConversionError с ErrFormat
+	formatErr := func() error {
+		return &ConversionError{Value: source, Target: boolType, Err: ErrFormat}
+	}
+
 	switch v := source.(type) {
 	case bool:
 		return v, nil
 	case string:
 		s := strings.ToLower(strings.TrimSpace(v))
+		if bc.Mode == Strict {
+			switch s {
+			case "true", "1":
+				return true, nil
+			case "false", "0":
+				return false, nil
+			default:
+				return nil, formatErr()
+			}
+		}
 		switch s {
 		case "true", "1", "yes", "on":
 			return true, nil
 		case "false", "0", "no", "off", "":
 			return false, nil
-		default:
-			return nil, fmt.Errorf("невозможно преобразовать строку '%s' в bool", v)
 		}
-	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
-		return fmt.Sprintf("%v", v) != "0", nil
-	case float32, float64:
-		return fmt.Sprintf("%v", v) != "0", nil
+		if bc.Mode == Lenient {
+			switch s {
+			case "y", "t":
+				return true, nil
+			case "n", "f":
+				return false, nil
+			}
+		}
+		return nil, formatErr()
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		s := fmt.Sprintf("%v", v)
+		if bc.Mode == Strict && s != "0" && s != "1" {
+			return nil, formatErr()
+		}
+		return s != "0", nil
 	default:
-		return nil, fmt.Errorf("невозможно преобразовать тип %T в bool", source)
+		return nil, formatErr()
 	}
 }
 
@@ -222,6 +199,10 @@ func (mc MapConverter) Convert(source any) (any, error) {
 	case map[string]any:
 		return v, nil
 	default:
+		if indirectType(reflect.TypeOf(v)).Kind() == reflect.Struct {
+			return StructToMap(v)
+		}
+
 		// Пытаемся преобразовать через JSON
 		jsonData, err := json.Marshal(v)
 		if err != nil {
@@ -1,6 +1,7 @@
 package cast
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -260,6 +261,21 @@ func TestBoolConverter_Convert(t *testing.T) {
 	}
 }
 
+func TestBoolConverterErrorType(t *testing.T) {
+	for _, mode := range []Mode{Default, Strict, Lenient} {
+		for _, input := range []any{"maybe", 2.5, []int{1}} {
+			if mode != Strict && input == 2.5 {
+				continue // вне строгого режима любое ненулевое число - true
+			}
+			_, err := BoolConverter{Mode: mode}.Convert(input)
+			var convErr *ConversionError
+			if !errors.As(err, &convErr) || !errors.Is(err, ErrFormat) || convErr.Target != boolType {
+				t.Errorf("Mode %d, Convert(%v) error = %#v, want *ConversionError with ErrFormat", mode, input, err)
+			}
+		}
+	}
+}
+
 func TestMapConverter_Convert(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,28 @@
+// Внешний тестовый пакет: nullable импортирует cast, поэтому внутренний тест
+// не может импортировать nullable без цикла
+package cast_test
+
+import (
+	"testing"
+	"time"
+
+	"types/cast"
+	"types/nullable"
+)
+
+func TestTo_Nullable(t *testing.T) {
+	n, err := cast.To[nullable.Type[time.Duration]]("1h30m")
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if n.IsNull() || n.V != 90*time.Minute {
+		t.Errorf("ожидалось 1h30m, получено %v", n)
+	}
+
+	for _, input := range []any{nil, ""} {
+		n, err := cast.To[nullable.Type[int]](input)
+		if err != nil || n.IsNotNull() {
+			t.Errorf("ожидался null для %#v, получено %v, %v", input, n, err)
+		}
+	}
+}
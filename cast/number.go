@@ -0,0 +1,311 @@
+package cast
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var (
+	float64Type = reflect.TypeFor[float64]()
+	boolType    = reflect.TypeFor[bool]()
+
+	// Каноническая запись десятичного числа
+	decimalPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d+)?$`)
+	// Размер с единицей измерения: 10MiB, 1.5 GB, 512B
+	sizePattern = regexp.MustCompile(`(?i)^(.+?)\s*(?:([kmgtpe])(i?))?b$`)
+)
+
+// Множители единиц измерения размера
+var sizeUnits = map[string]int64{
+	"":  1,
+	"k": 1e3,
+	"m": 1e6,
+	"g": 1e9,
+	"t": 1e12,
+	"p": 1e15,
+	"e": 1e18,
+}
+
+// convertScalar преобразует простое значение (строку, число, bool) в тип target
+func convertScalar(sv reflect.Value, target reflect.Type, mode Mode) (reflect.Value, error) {
+	switch {
+	case isNumberKind(sv.Kind()) && isNumberKind(target.Kind()) && mode == Default:
+		return sv.Convert(target), nil
+	case isNumberKind(target.Kind()):
+		if mode == Default {
+			num, err := NumberConverter{}.Convert(sv.Interface())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			return reflect.ValueOf(num).Convert(target), nil
+		}
+		return convertNumber(sv, target, mode)
+	case target.Kind() == reflect.Bool:
+		b, err := BoolConverter{Mode: mode}.Convert(sv.Interface())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(b).Convert(target), nil
+	case target.Kind() == reflect.String:
+		s, err := StringConverter{}.Convert(sv.Interface())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(s).Convert(target), nil
+	case sv.Type().ConvertibleTo(target):
+		return sv.Convert(target), nil
+	default:
+		return reflect.Value{}, fmt.Errorf("невозможно преобразовать %s в %s", sv.Type(), target)
+	}
+}
+
+// convertNumber преобразует значение в числовой тип target с проверкой
+// переполнения и потери точности
+func convertNumber(sv reflect.Value, target reflect.Type, mode Mode) (reflect.Value, error) {
+	fail := func(err error) (reflect.Value, error) {
+		return reflect.Value{}, &ConversionError{Value: sv.Interface(), Target: target, Err: err}
+	}
+
+	// NaN и бесконечности не имеют точного рационального представления
+	if sv.Kind() == reflect.Float32 || sv.Kind() == reflect.Float64 {
+		f := sv.Float()
+		switch {
+		case (math.IsNaN(f) || math.IsInf(f, 0)) && isFloatKind(target.Kind()):
+			return sv.Convert(target), nil
+		case math.IsNaN(f):
+			return fail(fmt.Errorf("%w: NaN не является числом", ErrFormat))
+		case math.IsInf(f, 0):
+			return fail(ErrOverflow)
+		}
+	}
+
+	r, err := toRat(sv, mode)
+	if err != nil {
+		return fail(err)
+	}
+
+	result, err := ratToNumber(r, target, mode)
+	if err != nil {
+		return fail(err)
+	}
+	return result, nil
+}
+
+// ratToNumber преобразует рациональное число в числовой тип target.
+// В режиме Strict дробная часть при преобразовании в целое считается потерей точности
+func ratToNumber(r *big.Rat, target reflect.Type, mode Mode) (reflect.Value, error) {
+	result := reflect.New(target).Elem()
+	switch {
+	case isFloatKind(target.Kind()):
+		var f float64
+		var exact bool
+		if target.Kind() == reflect.Float32 {
+			f32, ok := r.Float32()
+			f, exact = float64(f32), ok
+		} else {
+			f, exact = r.Float64()
+		}
+		if math.IsInf(f, 0) {
+			return reflect.Value{}, ErrOverflow
+		}
+		// Целые значения должны представляться точно, дроби вроде 0.1 округляются всегда
+		if mode == Strict && r.IsInt() && !exact {
+			return reflect.Value{}, ErrPrecisionLoss
+		}
+		result.SetFloat(f)
+	default:
+		i := new(big.Int).Quo(r.Num(), r.Denom())
+		if !r.IsInt() && mode == Strict {
+			return reflect.Value{}, ErrPrecisionLoss
+		}
+		lo, hi := intBounds(target)
+		if i.Cmp(lo) < 0 || i.Cmp(hi) > 0 {
+			return reflect.Value{}, ErrOverflow
+		}
+		if isSignedKind(target.Kind()) {
+			result.SetInt(i.Int64())
+		} else {
+			result.SetUint(i.Uint64())
+		}
+	}
+	return result, nil
+}
+
+// toRat возвращает точное рациональное значение числа, строки или bool
+func toRat(sv reflect.Value, mode Mode) (*big.Rat, error) {
+	switch {
+	case isSignedKind(sv.Kind()):
+		return new(big.Rat).SetInt64(sv.Int()), nil
+	case isNumberKind(sv.Kind()) && !isFloatKind(sv.Kind()):
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(sv.Uint())), nil
+	case isFloatKind(sv.Kind()):
+		return new(big.Rat).SetFloat64(sv.Float()), nil
+	case sv.Kind() == reflect.Bool:
+		if sv.Bool() {
+			return big.NewRat(1, 1), nil
+		}
+		return new(big.Rat), nil
+	case sv.Kind() == reflect.String:
+		return parseNumber(sv.String(), mode)
+	default:
+		return nil, fmt.Errorf("%w: тип %s не является числом", ErrFormat, sv.Type())
+	}
+}
+
+// parseNumber разбирает строку в точное рациональное число. В мягком режиме
+// дополнительно принимаются разделители разрядов, десятичная запятая,
+// литералы 0x/0o/0b и размеры с единицами измерения
+func parseNumber(s string, mode Mode) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	if mode == Lenient {
+		return parseLenientNumber(s)
+	}
+	if !decimalPattern.MatchString(s) {
+		return nil, fmt.Errorf("%w: %q не является десятичным числом", ErrFormat, s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q не является десятичным числом", ErrFormat, s)
+	}
+	return r, nil
+}
+
+func parseLenientNumber(s string) (*big.Rat, error) {
+	sign, body := splitSign(s)
+
+	// Литералы с префиксом системы счисления
+	if len(body) > 2 && body[0] == '0' && strings.ContainsRune("xXoObB", rune(body[1])) {
+		i, ok := new(big.Int).SetString(sign+body, 0)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q не является целым числом", ErrFormat, s)
+		}
+		return new(big.Rat).SetInt(i), nil
+	}
+
+	// Размеры: 10MiB, 1.5 GB, 512B
+	multiplier := big.NewRat(1, 1)
+	if m := sizePattern.FindStringSubmatch(body); m != nil {
+		unit := strings.ToLower(m[2])
+		if m[3] != "" {
+			multiplier.SetInt(new(big.Int).Lsh(big.NewInt(1), 10*uint(strings.Index("kmgtpe", unit)+1)))
+		} else {
+			multiplier.SetInt64(sizeUnits[unit])
+		}
+		body = m[1]
+	}
+
+	normalized, err := normalizeDecimal(body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrFormat, s, err)
+	}
+	r, ok := new(big.Rat).SetString(sign + normalized)
+	if !ok || !decimalPattern.MatchString(normalized) {
+		return nil, fmt.Errorf("%w: %q не является числом", ErrFormat, s)
+	}
+	return r.Mul(r, multiplier), nil
+}
+
+// normalizeDecimal убирает разделители разрядов и приводит десятичный
+// разделитель к точке: "1 234,56" -> "1234.56", "1,234,567.8" -> "1234567.8"
+func normalizeDecimal(s string) (string, error) {
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\u00a0', '\u202f', '_', '\'':
+			return -1
+		}
+		return r
+	}, s)
+
+	mantissa, exponent := s, ""
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mantissa, exponent = s[:i], s[i:]
+	}
+
+	commas := strings.Count(mantissa, ",")
+	dots := strings.Count(mantissa, ".")
+	var decimalSep, groupSep string
+
+	switch {
+	case commas > 0 && dots > 0:
+		// Десятичным считается последний из разделителей
+		if strings.LastIndex(mantissa, ",") > strings.LastIndex(mantissa, ".") {
+			decimalSep, groupSep = ",", "."
+		} else {
+			decimalSep, groupSep = ".", ","
+		}
+	case commas > 1:
+		groupSep = ","
+	case dots > 1:
+		groupSep = "."
+	case commas == 1:
+		// "1,234" трактуется как разделитель разрядов, "1,5" и "1234,56" - как десятичная запятая
+		if i := strings.Index(mantissa, ","); len(mantissa)-i-1 == 3 && i > 0 && i <= 3 {
+			groupSep = ","
+		} else {
+			decimalSep = ","
+		}
+	case dots == 1:
+		decimalSep = "."
+	}
+
+	if groupSep != "" {
+		mantissa = strings.ReplaceAll(mantissa, groupSep, "")
+	}
+	if decimalSep == "," {
+		mantissa = strings.Replace(mantissa, ",", ".", 1)
+	}
+	if strings.ContainsAny(mantissa, ",") || strings.Count(mantissa, ".") > 1 {
+		return "", errors.New("несколько десятичных разделителей")
+	}
+	return mantissa + exponent, nil
+}
+
+func splitSign(s string) (string, string) {
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		return s[:1], strings.TrimSpace(s[1:])
+	}
+	return "", s
+}
+
+// intBounds возвращает минимальное и максимальное значения целочисленного типа
+func intBounds(t reflect.Type) (*big.Int, *big.Int) {
+	bits := uint(t.Bits())
+	if isSignedKind(t.Kind()) {
+		hi := new(big.Int).Lsh(big.NewInt(1), bits-1)
+		lo := new(big.Int).Neg(hi)
+		return lo, hi.Sub(hi, big.NewInt(1))
+	}
+	hi := new(big.Int).Lsh(big.NewInt(1), bits)
+	return new(big.Int), hi.Sub(hi, big.NewInt(1))
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func isSignedKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isFloatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+func isScalarKind(k reflect.Kind) bool {
+	return isNumberKind(k) || k == reflect.Bool || k == reflect.String
+}
@@ -0,0 +1,163 @@
+package cast
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestStrict_Numbers(t *testing.T) {
+	strict := Options{Mode: Strict}
+
+	tests := []struct {
+		name    string
+		input   any
+		want    int8
+		wantErr error
+	}{
+		{"integral float", 42.0, 42, nil},
+		{"canonical string", "-17", -17, nil},
+		{"exponent string", "1e2", 100, nil},
+		{"fractional float", 3.9, 0, ErrPrecisionLoss},
+		{"fractional string", "2.5", 0, ErrPrecisionLoss},
+		{"overflow uint64", uint64(math.MaxUint64), 0, ErrOverflow},
+		{"overflow int", 128, 0, ErrOverflow},
+		{"infinity", math.Inf(1), 0, ErrOverflow},
+		{"hex not allowed", "0x10", 0, ErrFormat},
+		{"grouping not allowed", "1 000", 0, ErrFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToWithOptions[int8](tt.input, strict)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ожидалась ошибка %v, получено %v", tt.wantErr, err)
+				}
+				var ce *ConversionError
+				if !errors.As(err, &ce) {
+					t.Errorf("ожидалась ошибка ConversionError, получено %T", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("неожиданная ошибка: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ожидалось %d, получено %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStrict_IntToFloatPrecision(t *testing.T) {
+	strict := Options{Mode: Strict}
+
+	if _, err := ToWithOptions[float64](int64(1<<53+1), strict); !errors.Is(err, ErrPrecisionLoss) {
+		t.Errorf("ожидалась ErrPrecisionLoss, получено %v", err)
+	}
+	if _, err := ToWithOptions[float32](1e300, strict); !errors.Is(err, ErrOverflow) {
+		t.Errorf("ожидалась ErrOverflow, получено %v", err)
+	}
+	if f, err := ToWithOptions[float64]("0.1", strict); err != nil || f != 0.1 {
+		t.Errorf("ожидалось 0.1, получено %v, %v", f, err)
+	}
+}
+
+func TestStrict_Bool(t *testing.T) {
+	strict := Options{Mode: Strict}
+
+	if b, err := ToWithOptions[bool]("true", strict); err != nil || !b {
+		t.Errorf("ожидалось true, получено %v, %v", b, err)
+	}
+	if _, err := ToWithOptions[bool]("yes", strict); !errors.Is(err, ErrFormat) {
+		t.Errorf("ожидалась ErrFormat для 'yes', получено %v", err)
+	}
+	if _, err := ToWithOptions[bool](2, strict); !errors.Is(err, ErrFormat) {
+		t.Errorf("ожидалась ErrFormat для 2, получено %v", err)
+	}
+}
+
+func TestLenient_Numbers(t *testing.T) {
+	lenient := Options{Mode: Lenient}
+
+	tests := []struct {
+		name  string
+		input string
+		want  float64
+	}{
+		{"locale decimal comma", "1 234,56", 1234.56},
+		{"non-breaking space", "1\u00a0234,5", 1234.5},
+		{"us grouping", "1,234,567.25", 1234567.25},
+		{"eu grouping", "1.234.567,25", 1234567.25},
+		{"single group", "1,234", 1234},
+		{"hex", "0xFF", 255},
+		{"octal", "0o17", 15},
+		{"binary", "-0b101", -5},
+		{"mebibytes", "10MiB", 10 * 1024 * 1024},
+		{"gigabytes", "1.5 GB", 1.5e9},
+		{"bytes", "512B", 512},
+		{"exponent", "2,5e3", 2500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToWithOptions[float64](tt.input, lenient)
+			if err != nil {
+				t.Fatalf("неожиданная ошибка: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ожидалось %v, получено %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLenient_IntTruncatesButChecksOverflow(t *testing.T) {
+	lenient := Options{Mode: Lenient}
+
+	if v, err := ToWithOptions[int](3.9, lenient); err != nil || v != 3 {
+		t.Errorf("ожидалось 3, получено %v, %v", v, err)
+	}
+	if v, err := ToWithOptions[uint16]("64KiB", lenient); !errors.Is(err, ErrOverflow) {
+		t.Errorf("ожидалась ErrOverflow, получено %v, %v", v, err)
+	}
+	if _, err := ToWithOptions[int]("12abc", lenient); !errors.Is(err, ErrFormat) {
+		t.Errorf("ожидалась ErrFormat, получено %v", err)
+	}
+}
+
+func TestRegistry_Options(t *testing.T) {
+	r := NewRegistry()
+	r.SetOptions(Options{Mode: Strict})
+
+	type limits struct {
+		Max int8 `json:"max"`
+	}
+
+	_, err := ToWith[limits](r, map[string]any{"max": 300})
+	if !errors.Is(err, ErrOverflow) {
+		t.Fatalf("ожидалась ErrOverflow, получено %v", err)
+	}
+	var fe *FieldError
+	if !errors.As(err, &fe) || fe.Path != "max" {
+		t.Errorf("ожидалась ошибка с путем 'max', получено %v", err)
+	}
+
+	// Глобальный реестр по умолчанию сохраняет прежнее поведение
+	if v, err := To[int8](300); err != nil || v != 44 {
+		t.Errorf("ожидалось 44, получено %v, %v", v, err)
+	}
+}
+
+func TestNumberConverter_Modes(t *testing.T) {
+	if _, err := (NumberConverter{Mode: Strict}).Convert(uint64(math.MaxUint64)); !errors.Is(err, ErrPrecisionLoss) {
+		t.Errorf("ожидалась ErrPrecisionLoss, получено %v", err)
+	}
+	if v, err := (NumberConverter{Mode: Lenient}).Convert("1 234,5"); err != nil || v != 1234.5 {
+		t.Errorf("ожидалось 1234.5, получено %v, %v", v, err)
+	}
+	if _, err := (NumberConverter{}).Convert("abc"); !errors.Is(err, ErrFormat) {
+		t.Errorf("ожидалась ErrFormat, получено %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package cast
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Mode определяет, насколько строго преобразуются числа и булевы значения
+type Mode uint8
+
+const (
+	// Default сохраняет поведение reflect-преобразований без дополнительных проверок
+	Default Mode = iota
+	// Strict запрещает потерю точности, переполнение и неканонические булевы значения вроде "yes"
+	Strict
+	// Lenient принимает числа в локальном формате ("1 234,56"), литералы 0x/0o/0b
+	// и размеры вида "10MiB", дробная часть при преобразовании в целое отбрасывается
+	Lenient
+)
+
+// Options задает параметры преобразования
+type Options struct {
+	Mode Mode
+	// TimeLayouts - форматы разбора строк во время; первый используется и для
+	// форматирования времени в строку. По умолчанию DefaultTimeLayouts
+	TimeLayouts []string
+	// Location - часовой пояс для строк без смещения и для Unix-меток, по умолчанию UTC.
+	// Если задан, время форматируется в строку в этом поясе, иначе в собственном поясе значения
+	Location *time.Location
+	// UnixUnit - единица числовых меток времени (time.Second, time.Millisecond, ...).
+	// Нулевое значение означает автоопределение по величине метки при разборе
+	// и секунды при преобразовании времени в число
+	UnixUnit time.Duration
+	// DurationUnit - единица чисел для time.Duration (time.Second, time.Millisecond, ...).
+	// Нулевое значение означает секунды при разборе числа в Duration и наносекунды,
+	// как при обычном приведении типов, при преобразовании Duration в число
+	DurationUnit time.Duration
+}
+
+// Ошибки, позволяющие отличить причину неудачного преобразования
+var (
+	ErrOverflow      = errors.New("значение выходит за пределы целевого типа")
+	ErrPrecisionLoss = errors.New("преобразование приводит к потере точности")
+	ErrFormat        = errors.New("неверный формат значения")
+)
+
+// ConversionError описывает неудачное преобразование значения в целевой тип.
+// Err оборачивает одну из ошибок ErrOverflow, ErrPrecisionLoss или ErrFormat
+type ConversionError struct {
+	Value  any
+	Target reflect.Type
+	Err    error
+}
+
+// Error реализует интерфейс error
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("невозможно преобразовать %v (%T) в %s: %v", e.Value, e.Value, e.Target, e.Err)
+}
+
+// Unwrap возвращает причину ошибки
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,293 @@
+package cast
+
+import (
+	"database/sql"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+var (
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	stringerType        = reflect.TypeFor[fmt.Stringer]()
+	scannerType         = reflect.TypeFor[sql.Scanner]()
+)
+
+// typePair - ключ реестра: тип источника и целевой тип
+type typePair struct {
+	from reflect.Type
+	to   reflect.Type
+}
+
+// Registry хранит конвертеры для пар (тип источника, целевой тип).
+// Реестры независимы друг от друга, поэтому разные подсистемы могут
+// использовать собственные наборы преобразований
+type Registry struct {
+	converters map[typePair]ConvertFunc
+	interfaces []typePair // пары с интерфейсом-источником в порядке регистрации
+	options    Options
+	mu         sync.RWMutex
+}
+
+// conversion объединяет реестр и параметры одного преобразования
+type conversion struct {
+	reg  *Registry
+	opts Options
+}
+
+// defaultRegistry используется функциями To и ConvertTo
+var defaultRegistry = NewRegistry()
+
+// NewRegistry создает новый пустой реестр конвертеров
+func NewRegistry() *Registry {
+	return &Registry{
+		converters: make(map[typePair]ConvertFunc),
+	}
+}
+
+// DefaultRegistry возвращает глобальный реестр, который используют To и ConvertTo
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register регистрирует функцию преобразования S -> T в глобальном реестре
+func Register[S, T any](fn func(S) (T, error)) {
+	RegisterIn(defaultRegistry, fn)
+}
+
+// RegisterIn регистрирует функцию преобразования S -> T в указанном реестре.
+// S может быть интерфейсом, тогда конвертер применяется ко всем типам, реализующим его
+func RegisterIn[S, T any](r *Registry, fn func(S) (T, error)) {
+	r.RegisterFunc(reflect.TypeFor[S](), reflect.TypeFor[T](), func(source any) (any, error) {
+		s, ok := source.(S)
+		if !ok {
+			return nil, fmt.Errorf("ожидался тип %v, получено %T", reflect.TypeFor[S](), source)
+		}
+		return fn(s)
+	})
+}
+
+// RegisterFunc регистрирует конвертер для пары типов, заменяя ранее зарегистрированный.
+// Повторная регистрация для интерфейса считается самой поздней
+func (r *Registry) RegisterFunc(from, to reflect.Type, fn ConvertFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pair := typePair{from: from, to: to}
+	r.converters[pair] = fn
+	if from.Kind() == reflect.Interface {
+		r.interfaces = slices.DeleteFunc(r.interfaces, func(p typePair) bool { return p == pair })
+		r.interfaces = append(r.interfaces, pair)
+	}
+}
+
+// Lookup возвращает конвертер для пары типов. Сначала ищется точное совпадение,
+// затем конвертер, зарегистрированный для интерфейса, который реализует from.
+// Если from реализует несколько таких интерфейсов, побеждает зарегистрированный последним
+func (r *Registry) Lookup(from, to reflect.Type) (Converter, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if fn, ok := r.converters[typePair{from: from, to: to}]; ok {
+		return fn, true
+	}
+	for i := len(r.interfaces) - 1; i >= 0; i-- {
+		pair := r.interfaces[i]
+		if pair.to == to && from.Implements(pair.from) {
+			return r.converters[pair], true
+		}
+	}
+	return nil, false
+}
+
+// SetOptions задает параметры преобразования, используемые реестром
+func (r *Registry) SetOptions(opts Options) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.options = opts
+}
+
+// Options возвращает текущие параметры преобразования реестра
+func (r *Registry) Options() Options {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.options
+}
+
+// Clone создает независимую копию реестра со всеми зарегистрированными конвертерами и параметрами
+func (r *Registry) Clone() *Registry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	clone := NewRegistry()
+	clone.options = r.options
+	for pair, fn := range r.converters {
+		clone.converters[pair] = fn
+	}
+	clone.interfaces = slices.Clone(r.interfaces)
+	return clone
+}
+
+// ConvertTo преобразует значение к целевому типу. Порядок попыток:
+// зарегистрированный конвертер, встроенные преобразования времени, UUID,
+// big.Big, rational.Rational и контейнеров, интерфейсы encoding.TextUnmarshaler,
+// sql.Scanner и fmt.Stringer, прямое преобразование через reflect,
+// отображение структур и карт и, наконец, JSON маршалинг/анмаршалинг
+func (r *Registry) ConvertTo(source any, targetType reflect.Type) (any, error) {
+	return r.conversion().convert(source, targetType)
+}
+
+// conversion возвращает преобразование с текущими параметрами реестра
+func (r *Registry) conversion() conversion {
+	return conversion{reg: r, opts: r.Options()}
+}
+
+func (c conversion) convert(source any, targetType reflect.Type) (any, error) {
+	if source == nil {
+		return reflect.Zero(targetType).Interface(), nil
+	}
+
+	sourceValue := reflect.ValueOf(source)
+	sourceType := sourceValue.Type()
+
+	// Если типы совпадают
+	if sourceType == targetType {
+		return source, nil
+	}
+
+	if conv, ok := c.reg.Lookup(sourceType, targetType); ok {
+		return conv.Convert(source)
+	}
+
+	// Время, длительности, UUID, числа пакетов big и rational, nullable и option
+	if result, ok, err := c.convertBuiltin(sourceValue, targetType); ok {
+		return result, err
+	}
+
+	if result, ok, err := convertByInterface(sourceValue, targetType); ok {
+		return result, err
+	}
+
+	// Числа и булевы значения в строгом и мягком режимах
+	if c.opts.Mode != Default && isScalarKind(targetType.Kind()) && isScalarKind(sourceType.Kind()) {
+		result, err := convertScalar(sourceValue, targetType, c.opts.Mode)
+		if err != nil {
+			return nil, err
+		}
+		return result.Interface(), nil
+	}
+
+	// Попытка прямого преобразования через reflect
+	if sourceType.ConvertibleTo(targetType) {
+		return sourceValue.Convert(targetType).Interface(), nil
+	}
+
+	// Преобразование между структурами и картами
+	if result, ok, err := c.convertStruct(source, targetType); ok {
+		return result, err
+	}
+
+	// Преобразование через JSON
+	jsonData, err := json.Marshal(source)
+	if err != nil {
+		return nil, fmt.Errorf(jsonMarshalErrMsg, err)
+	}
+
+	result := reflect.New(targetType)
+	err = json.Unmarshal(jsonData, result.Interface())
+	if err != nil {
+		return nil, fmt.Errorf(jsonUnmarshalErrMsg, err)
+	}
+
+	return result.Elem().Interface(), nil
+}
+
+// ToWith преобразует значение в тип T, используя указанный реестр
+func ToWith[T any](r *Registry, value any) (T, error) {
+	result, err := r.ConvertTo(value, reflect.TypeFor[T]())
+	return typed[T](value, result, err)
+}
+
+// ToWithOptions преобразует значение в тип T, используя конвертеры глобального реестра
+// и указанные параметры вместо параметров реестра
+func ToWithOptions[T any](value any, opts Options) (T, error) {
+	c := conversion{reg: defaultRegistry, opts: opts}
+	result, err := c.convert(value, reflect.TypeFor[T]())
+	return typed[T](value, result, err)
+}
+
+// typed приводит результат преобразования к T. Конвертер, зарегистрированный
+// через RegisterFunc, может вернуть значение другого типа - это ошибка, а не паника
+func typed[T any](value, result any, err error) (T, error) {
+	var zero T
+	if err != nil || result == nil {
+		return zero, err
+	}
+	t, ok := result.(T)
+	if !ok {
+		return zero, &ConversionError{
+			Value:  value,
+			Target: reflect.TypeFor[T](),
+			Err:    fmt.Errorf("%w: конвертер вернул значение типа %T", ErrFormat, result),
+		}
+	}
+	return t, nil
+}
+
+// convertByInterface выполняет преобразование через стандартные интерфейсы.
+// Второй результат сообщает, был ли найден подходящий интерфейс
+func convertByInterface(sv reflect.Value, targetType reflect.Type) (any, bool, error) {
+	ptrType := reflect.PointerTo(targetType)
+
+	if ptrType.Implements(textUnmarshalerType) {
+		if text, ok := textOf(sv); ok {
+			result := reflect.New(targetType)
+			if err := result.Interface().(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+				return nil, true, err
+			}
+			return result.Elem().Interface(), true, nil
+		}
+	}
+
+	if ptrType.Implements(scannerType) {
+		result := reflect.New(targetType)
+		if err := result.Interface().(sql.Scanner).Scan(sv.Interface()); err != nil {
+			return nil, true, err
+		}
+		return result.Elem().Interface(), true, nil
+	}
+
+	if targetType.Kind() == reflect.String {
+		switch {
+		case sv.Type().Implements(textMarshalerType):
+			text, err := sv.Interface().(encoding.TextMarshaler).MarshalText()
+			if err != nil {
+				return nil, true, err
+			}
+			return reflect.ValueOf(string(text)).Convert(targetType).Interface(), true, nil
+		case sv.Type().Implements(stringerType):
+			s := sv.Interface().(fmt.Stringer).String()
+			return reflect.ValueOf(s).Convert(targetType).Interface(), true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// textOf возвращает текстовое представление строки, []byte или encoding.TextMarshaler
+func textOf(sv reflect.Value) ([]byte, bool) {
+	switch {
+	case sv.Kind() == reflect.String:
+		return []byte(sv.String()), true
+	case sv.Kind() == reflect.Slice && sv.Type().Elem().Kind() == reflect.Uint8:
+		return sv.Bytes(), true
+	case sv.Type().Implements(textMarshalerType):
+		text, err := sv.Interface().(encoding.TextMarshaler).MarshalText()
+		return text, err == nil
+	}
+	return nil, false
+}
@@ -0,0 +1,184 @@
+package cast
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testCelsius float64
+
+type testPoint struct {
+	X, Y int
+}
+
+func (p testPoint) String() string {
+	return fmt.Sprintf("(%d,%d)", p.X, p.Y)
+}
+
+type testLevel int
+
+func (l *testLevel) Scan(value any) error {
+	s, ok := value.(string)
+	if !ok {
+		return errors.New("ожидалась строка")
+	}
+	switch s {
+	case "low":
+		*l = 1
+	case "high":
+		*l = 2
+	default:
+		return fmt.Errorf("неизвестный уровень %q", s)
+	}
+	return nil
+}
+
+func TestRegistry_RegisterIn(t *testing.T) {
+	r := NewRegistry()
+	RegisterIn(r, func(s string) (testPoint, error) {
+		var p testPoint
+		_, err := fmt.Sscanf(s, "%d:%d", &p.X, &p.Y)
+		return p, err
+	})
+
+	p, err := ToWith[testPoint](r, "3:4")
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if p != (testPoint{X: 3, Y: 4}) {
+		t.Errorf("ожидалось (3,4), получено %v", p)
+	}
+
+	// Глобальный реестр не должен видеть конвертер изолированного реестра
+	if _, ok := DefaultRegistry().Lookup(reflect.TypeFor[string](), reflect.TypeFor[testPoint]()); ok {
+		t.Errorf("конвертер не должен попадать в глобальный реестр")
+	}
+}
+
+func TestRegistry_InterfaceSource(t *testing.T) {
+	r := NewRegistry()
+	RegisterIn(r, func(s fmt.Stringer) (testCelsius, error) {
+		return testCelsius(len(s.String())), nil
+	})
+
+	c, err := ToWith[testCelsius](r, testPoint{X: 10, Y: 20})
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if c != 7 {
+		t.Errorf("ожидалось 7, получено %v", c)
+	}
+}
+
+// testLabeled - второй интерфейс, который реализует testPoint
+type testLabeled interface {
+	String() string
+}
+
+func TestRegistry_InterfacePrecedence(t *testing.T) {
+	r := NewRegistry()
+	RegisterIn(r, func(s fmt.Stringer) (testCelsius, error) { return 1, nil })
+	RegisterIn(r, func(s testLabeled) (testCelsius, error) { return 2, nil })
+
+	// Побеждает зарегистрированный последним, независимо от порядка обхода карты
+	for i := 0; i < 100; i++ {
+		if c, err := ToWith[testCelsius](r, testPoint{}); err != nil || c != 2 {
+			t.Fatalf("ожидалось 2, получено %v, %v", c, err)
+		}
+	}
+
+	// Повторная регистрация переносит конвертер в конец, клон сохраняет порядок
+	RegisterIn(r, func(s fmt.Stringer) (testCelsius, error) { return 3, nil })
+	clone := r.Clone()
+	for _, reg := range []*Registry{r, clone} {
+		if c, err := ToWith[testCelsius](reg, testPoint{}); err != nil || c != 3 {
+			t.Errorf("ожидалось 3, получено %v, %v", c, err)
+		}
+	}
+}
+
+func TestRegistry_WrongResultType(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterFunc(reflect.TypeFor[string](), reflect.TypeFor[testCelsius](), func(source any) (any, error) {
+		return "не testCelsius", nil
+	})
+
+	_, err := ToWith[testCelsius](r, "x")
+	var convErr *ConversionError
+	if !errors.As(err, &convErr) || convErr.Target != reflect.TypeFor[testCelsius]() {
+		t.Errorf("ожидалась *ConversionError, получено %v", err)
+	}
+}
+
+func TestRegistry_Clone(t *testing.T) {
+	base := NewRegistry()
+	RegisterIn(base, func(s string) (testCelsius, error) { return 1, nil })
+
+	clone := base.Clone()
+	RegisterIn(clone, func(s string) (testCelsius, error) { return 2, nil })
+
+	a, _ := ToWith[testCelsius](base, "x")
+	b, _ := ToWith[testCelsius](clone, "x")
+	if a != 1 || b != 2 {
+		t.Errorf("ожидалось 1 и 2, получено %v и %v", a, b)
+	}
+}
+
+func TestRegistry_FieldConverter(t *testing.T) {
+	type reading struct {
+		Temp testCelsius `json:"temp"`
+	}
+
+	r := NewRegistry()
+	RegisterIn(r, func(s string) (testCelsius, error) {
+		var v float64
+		_, err := fmt.Sscanf(strings.TrimSuffix(s, "C"), "%g", &v)
+		return testCelsius(v), err
+	})
+
+	got, err := ToWith[reading](r, map[string]any{"temp": "21.5C"})
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if got.Temp != 21.5 {
+		t.Errorf("ожидалось 21.5, получено %v", got.Temp)
+	}
+}
+
+func TestTo_TextUnmarshaler(t *testing.T) {
+	ip, err := To[net.IP]("192.168.0.1")
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if !ip.Equal(net.IPv4(192, 168, 0, 1)) {
+		t.Errorf("получено %v", ip)
+	}
+}
+
+func TestTo_Scanner(t *testing.T) {
+	level, err := To[testLevel]("high")
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if level != 2 {
+		t.Errorf("ожидалось 2, получено %v", level)
+	}
+
+	if _, err := To[testLevel]("extreme"); err == nil {
+		t.Errorf("ожидалась ошибка для неизвестного уровня")
+	}
+}
+
+func TestTo_Stringer(t *testing.T) {
+	s, err := To[string](testPoint{X: 1, Y: 2})
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if s != "(1,2)" {
+		t.Errorf("ожидалось '(1,2)', получено '%s'", s)
+	}
+}
@@ -0,0 +1,486 @@
+package cast
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Имя тега, который имеет приоритет над тегом json
+const tagName = "cast"
+
+var (
+	timeType   = reflect.TypeOf(time.Time{})
+	anyMapType = reflect.TypeOf(map[string]any{})
+)
+
+// FieldError описывает ошибку преобразования конкретного поля.
+// Path содержит полный путь к полю, например items[3].price
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+// Error реализует интерфейс error
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("поле %s: %v", e.Path, e.Err)
+}
+
+// Unwrap возвращает исходную ошибку
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// StructToMap преобразует структуру (или указатель на структуру) в map[string]any.
+// Имена ключей берутся из тегов cast и json, вложенные структуры, срезы и карты
+// преобразуются рекурсивно, значения time.Time сохраняются как есть
+func StructToMap(value any) (map[string]any, error) {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("ожидалась структура, получено %T", value)
+	}
+	return encodeStruct(v), nil
+}
+
+// MapToStruct заполняет структуру, на которую указывает target, значениями из карты.
+// Ключи сопоставляются с полями по тегам cast и json без учета регистра
+func MapToStruct(source map[string]any, target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return fmt.Errorf("ожидался непустой указатель на структуру, получено %T", target)
+	}
+	if v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("ожидался указатель на структуру, получено %T", target)
+	}
+	return defaultRegistry.conversion().decodeValue("", source, v.Elem())
+}
+
+// convertStruct выполняет преобразования map -> struct, struct -> struct и struct -> map.
+// Второй результат сообщает, подходит ли пара типов для такого преобразования
+func (c conversion) convertStruct(source any, targetType reflect.Type) (any, bool, error) {
+	sourceValue := reflect.ValueOf(source)
+	sourceKind := indirectType(sourceValue.Type()).Kind()
+
+	if targetType == anyMapType && sourceKind == reflect.Struct && !isTimeType(sourceValue.Type()) {
+		m, err := StructToMap(source)
+		return m, true, err
+	}
+
+	if !isStructType(indirectType(targetType)) {
+		return nil, false, nil
+	}
+	if sourceKind != reflect.Map && (sourceKind != reflect.Struct || isTimeType(sourceValue.Type())) {
+		return nil, false, nil
+	}
+
+	result := reflect.New(targetType).Elem()
+	if err := c.decodeValue("", source, result); err != nil {
+		return nil, true, err
+	}
+	return result.Interface(), true, nil
+}
+
+// fieldInfo описывает поле структуры, участвующее в преобразовании
+type fieldInfo struct {
+	name      string
+	index     []int
+	omitEmpty bool
+	depth     int
+}
+
+// structFields возвращает поля структуры с учетом тегов и встроенных структур.
+// При совпадении имен побеждает поле с меньшей глубиной вложенности
+func structFields(t reflect.Type) []fieldInfo {
+	var fields []fieldInfo
+	collectFields(t, nil, 0, &fields)
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].depth < fields[j].depth
+	})
+
+	seen := make(map[string]bool, len(fields))
+	result := fields[:0]
+	for _, f := range fields {
+		if seen[f.name] {
+			continue
+		}
+		seen[f.name] = true
+		result = append(result, f)
+	}
+	return result
+}
+
+func collectFields(t reflect.Type, index []int, depth int, fields *[]fieldInfo) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, omitEmpty, skip := parseTag(sf)
+		if skip {
+			continue
+		}
+
+		fieldIndex := make([]int, len(index)+1)
+		copy(fieldIndex, index)
+		fieldIndex[len(index)] = i
+
+		// Встроенная структура без явного имени раскрывается в родительскую
+		if sf.Anonymous && name == "" {
+			if !sf.IsExported() && sf.Type.Kind() == reflect.Pointer {
+				continue
+			}
+			ft := indirectType(sf.Type)
+			if ft.Kind() == reflect.Struct && ft != timeType {
+				collectFields(ft, fieldIndex, depth+1, fields)
+				continue
+			}
+		}
+
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		*fields = append(*fields, fieldInfo{
+			name:      name,
+			index:     fieldIndex,
+			omitEmpty: omitEmpty,
+			depth:     depth,
+		})
+	}
+}
+
+// parseTag разбирает теги cast и json поля
+func parseTag(sf reflect.StructField) (name string, omitEmpty bool, skip bool) {
+	tag, ok := sf.Tag.Lookup(tagName)
+	if !ok {
+		tag, ok = sf.Tag.Lookup("json")
+	}
+	if !ok {
+		return "", false, false
+	}
+	if tag == "-" {
+		return "", false, true
+	}
+
+	parts := strings.Split(tag, ",")
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return parts[0], omitEmpty, false
+}
+
+func encodeStruct(v reflect.Value) map[string]any {
+	fields := structFields(v.Type())
+	result := make(map[string]any, len(fields))
+	for _, f := range fields {
+		fv, ok := fieldByIndex(v, f.index, false)
+		if !ok {
+			continue
+		}
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+		result[f.name] = encodeValue(fv)
+	}
+	return result
+}
+
+func encodeValue(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return encodeValue(v.Elem())
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface()
+		}
+		return encodeStruct(v)
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface()
+		}
+		fallthrough
+	case reflect.Array:
+		result := make([]any, v.Len())
+		for i := range result {
+			result[i] = encodeValue(v.Index(i))
+		}
+		return result
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		result := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			result[mapKeyString(iter.Key())] = encodeValue(iter.Value())
+		}
+		return result
+	default:
+		if !v.CanInterface() {
+			return nil
+		}
+		return v.Interface()
+	}
+}
+
+// decodeValue записывает source в dst, рекурсивно обходя составные типы
+func (c conversion) decodeValue(path string, source any, dst reflect.Value) error {
+	if source == nil {
+		dst.SetZero()
+		return nil
+	}
+
+	sv := reflect.ValueOf(source)
+	if sv.Type().AssignableTo(dst.Type()) {
+		dst.Set(sv)
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.Pointer:
+		for sv.Kind() == reflect.Pointer {
+			if sv.IsNil() {
+				dst.SetZero()
+				return nil
+			}
+			sv = sv.Elem()
+		}
+		elem := reflect.New(dst.Type().Elem())
+		if err := c.decodeValue(path, sv.Interface(), elem.Elem()); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	case reflect.Interface:
+		if sv.Type().Implements(dst.Type()) {
+			dst.Set(sv)
+			return nil
+		}
+		return fieldError(path, fmt.Errorf("тип %s не реализует %s", sv.Type(), dst.Type()))
+	}
+
+	sv = reflect.Indirect(sv)
+	if !sv.IsValid() {
+		dst.SetZero()
+		return nil
+	}
+
+	if conv, ok := c.reg.Lookup(sv.Type(), dst.Type()); ok {
+		value, err := conv.Convert(sv.Interface())
+		if err != nil {
+			return fieldError(path, err)
+		}
+		return setValue(path, value, dst)
+	}
+	if value, ok, err := c.convertBuiltin(sv, dst.Type()); ok {
+		if err != nil {
+			return fieldError(path, err)
+		}
+		return setValue(path, value, dst)
+	}
+	if value, ok, err := convertByInterface(sv, dst.Type()); ok {
+		if err != nil {
+			return fieldError(path, err)
+		}
+		return setValue(path, value, dst)
+	}
+
+	switch dst.Kind() {
+	case reflect.Struct:
+		return c.decodeStruct(path, sv, dst)
+	case reflect.Map:
+		return c.decodeMap(path, sv, dst)
+	case reflect.Slice, reflect.Array:
+		return c.decodeSlice(path, sv, dst)
+	default:
+		value, err := convertScalar(sv, dst.Type(), c.opts.Mode)
+		if err != nil {
+			return fieldError(path, err)
+		}
+		dst.Set(value)
+		return nil
+	}
+}
+
+func (c conversion) decodeStruct(path string, sv reflect.Value, dst reflect.Value) error {
+	switch sv.Kind() {
+	case reflect.Map:
+	case reflect.Struct:
+		sv = reflect.ValueOf(encodeStruct(sv))
+	default:
+		return fieldError(path, fmt.Errorf("невозможно преобразовать %s в структуру %s", sv.Type(), dst.Type()))
+	}
+	if sv.Type().Key().Kind() != reflect.String {
+		return fieldError(path, fmt.Errorf("ключи карты должны быть строками, получено %s", sv.Type().Key()))
+	}
+
+	keys := make(map[string]reflect.Value, sv.Len())
+	iter := sv.MapRange()
+	for iter.Next() {
+		keys[iter.Key().String()] = iter.Value()
+	}
+
+	for _, f := range structFields(dst.Type()) {
+		raw, ok := lookupKey(keys, f.name)
+		if !ok {
+			continue
+		}
+		fv, _ := fieldByIndex(dst, f.index, true)
+		if err := c.decodeValue(joinPath(path, f.name), raw.Interface(), fv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c conversion) decodeMap(path string, sv reflect.Value, dst reflect.Value) error {
+	if sv.Kind() == reflect.Struct {
+		sv = reflect.ValueOf(encodeStruct(sv))
+	}
+	if sv.Kind() != reflect.Map {
+		return fieldError(path, fmt.Errorf("невозможно преобразовать %s в карту %s", sv.Type(), dst.Type()))
+	}
+
+	dt := dst.Type()
+	result := reflect.MakeMapWithSize(dt, sv.Len())
+	iter := sv.MapRange()
+	for iter.Next() {
+		keyPath := joinPath(path, mapKeyString(iter.Key()))
+
+		key := reflect.New(dt.Key()).Elem()
+		if err := c.decodeValue(keyPath, iter.Key().Interface(), key); err != nil {
+			return err
+		}
+		elem := reflect.New(dt.Elem()).Elem()
+		if err := c.decodeValue(keyPath, iter.Value().Interface(), elem); err != nil {
+			return err
+		}
+		result.SetMapIndex(key, elem)
+	}
+	dst.Set(result)
+	return nil
+}
+
+func (c conversion) decodeSlice(path string, sv reflect.Value, dst reflect.Value) error {
+	if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+		return fieldError(path, fmt.Errorf("невозможно преобразовать %s в %s", sv.Type(), dst.Type()))
+	}
+
+	n := sv.Len()
+	if dst.Kind() == reflect.Array {
+		if n > dst.Len() {
+			return fieldError(path, fmt.Errorf("длина %d превышает размер массива %d", n, dst.Len()))
+		}
+		dst.SetZero()
+	} else {
+		dst.Set(reflect.MakeSlice(dst.Type(), n, n))
+	}
+
+	for i := 0; i < n; i++ {
+		if err := c.decodeValue(fmt.Sprintf("%s[%d]", path, i), sv.Index(i).Interface(), dst.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setValue записывает результат конвертера в dst, проверяя совместимость типов
+func setValue(path string, value any, dst reflect.Value) error {
+	if value == nil {
+		dst.SetZero()
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	if !v.Type().AssignableTo(dst.Type()) {
+		return fieldError(path, fmt.Errorf("конвертер вернул %s вместо %s", v.Type(), dst.Type()))
+	}
+	dst.Set(v)
+	return nil
+}
+
+// fieldByIndex возвращает поле по индексу, проходя через встроенные указатели.
+// При alloc == true нулевые указатели выделяются, иначе поле считается отсутствующим
+func fieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// lookupKey ищет ключ сначала точно, затем без учета регистра
+func lookupKey(keys map[string]reflect.Value, name string) (reflect.Value, bool) {
+	if v, ok := keys[name]; ok {
+		return v, true
+	}
+	for k, v := range keys {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func fieldError(path string, err error) error {
+	var fe *FieldError
+	if path == "" || errors.As(err, &fe) {
+		return err
+	}
+	return &FieldError{Path: path, Err: err}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func mapKeyString(key reflect.Value) string {
+	if key.Kind() == reflect.String {
+		return key.String()
+	}
+	return fmt.Sprint(key.Interface())
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+func isTimeType(t reflect.Type) bool {
+	return indirectType(t) == timeType
+}
+
+func isStructType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != timeType
+}
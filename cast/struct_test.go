@@ -0,0 +1,156 @@
+package cast
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type testAddress struct {
+	City string `json:"city"`
+	Zip  string `cast:"zip,omitempty"`
+}
+
+type testBase struct {
+	ID      int       `json:"id"`
+	Created time.Time `json:"created"`
+}
+
+type testItem struct {
+	Name  string  `json:"name"`
+	Price float64 `json:"price"`
+}
+
+type testOrder struct {
+	testBase
+	Customer string            `cast:"customer" json:"client"`
+	Address  *testAddress      `json:"address"`
+	Items    []testItem        `json:"items"`
+	Tags     map[string]int    `json:"tags"`
+	Note     string            `json:"note,omitempty"`
+	Secret   string            `json:"-"`
+	Extra    map[string]string `json:"extra,omitempty"`
+}
+
+func TestTo_MapToStruct(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	source := map[string]any{
+		"id":       float64(7),
+		"created":  created.Format(time.RFC3339),
+		"customer": "Alice",
+		"address":  map[string]any{"city": "Paris", "zip": "75001"},
+		"items": []any{
+			map[string]any{"name": "book", "price": 12.5},
+			map[string]any{"name": "pen", "price": "1.25"},
+		},
+		"tags":   map[string]any{"a": 1, "b": float64(2)},
+		"Secret": "hidden",
+	}
+
+	order, err := To[testOrder](source)
+	if err != nil {
+		t.Fatalf("ошибка при преобразовании map в структуру: %v", err)
+	}
+
+	if order.ID != 7 || !order.Created.Equal(created) {
+		t.Errorf("встроенная структура заполнена неверно: %+v", order.testBase)
+	}
+	if order.Customer != "Alice" {
+		t.Errorf("ожидалось 'Alice', получено '%s'", order.Customer)
+	}
+	if order.Address == nil || order.Address.City != "Paris" || order.Address.Zip != "75001" {
+		t.Errorf("адрес заполнен неверно: %+v", order.Address)
+	}
+	if len(order.Items) != 2 || order.Items[1].Price != 1.25 {
+		t.Errorf("элементы заполнены неверно: %+v", order.Items)
+	}
+	if order.Tags["b"] != 2 {
+		t.Errorf("ожидалось 2, получено %d", order.Tags["b"])
+	}
+	if order.Secret != "" {
+		t.Errorf("поле с тегом '-' не должно заполняться")
+	}
+}
+
+func TestTo_MapToStructPointer(t *testing.T) {
+	addr, err := To[*testAddress](map[string]any{"CITY": "Oslo"})
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if addr == nil || addr.City != "Oslo" {
+		t.Errorf("ожидалось 'Oslo', получено %+v", addr)
+	}
+}
+
+func TestTo_StructToMap(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	order := testOrder{
+		testBase: testBase{ID: 3, Created: created},
+		Customer: "Bob",
+		Address:  &testAddress{City: "Rome"},
+		Items:    []testItem{{Name: "cup", Price: 4}},
+		Secret:   "hidden",
+	}
+
+	m, err := To[map[string]any](order)
+	if err != nil {
+		t.Fatalf("ошибка при преобразовании структуры в map: %v", err)
+	}
+
+	if m["id"] != 3 || m["created"] != created {
+		t.Errorf("поля встроенной структуры должны быть раскрыты: %v", m)
+	}
+	if m["customer"] != "Bob" {
+		t.Errorf("тег cast должен иметь приоритет над json: %v", m)
+	}
+	if _, ok := m["note"]; ok {
+		t.Errorf("пустое поле с omitempty не должно попадать в map")
+	}
+	if _, ok := m["Secret"]; ok {
+		t.Errorf("поле с тегом '-' не должно попадать в map")
+	}
+
+	addr, ok := m["address"].(map[string]any)
+	if !ok || addr["city"] != "Rome" {
+		t.Errorf("вложенная структура должна стать map: %v", m["address"])
+	}
+	if _, ok := addr["zip"]; ok {
+		t.Errorf("пустое поле с omitempty не должно попадать в map")
+	}
+
+	items, ok := m["items"].([]any)
+	if !ok || len(items) != 1 || items[0].(map[string]any)["price"] != float64(4) {
+		t.Errorf("срез структур преобразован неверно: %v", m["items"])
+	}
+}
+
+func TestTo_StructErrorPath(t *testing.T) {
+	source := map[string]any{
+		"items": []any{
+			map[string]any{"price": 1},
+			map[string]any{"price": 2},
+			map[string]any{"price": 3},
+			map[string]any{"price": "free"},
+		},
+	}
+
+	_, err := To[testOrder](source)
+	var fe *FieldError
+	if !errors.As(err, &fe) {
+		t.Fatalf("ожидалась ошибка FieldError, получено %v", err)
+	}
+	if fe.Path != "items[3].price" {
+		t.Errorf("ожидался путь 'items[3].price', получено '%s'", fe.Path)
+	}
+}
+
+func TestMapConverter_Struct(t *testing.T) {
+	result, err := MapConverter{}.Convert(&testAddress{City: "Kyiv", Zip: "01001"})
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	m := result.(map[string]any)
+	if m["city"] != "Kyiv" || m["zip"] != "01001" {
+		t.Errorf("получено %v", m)
+	}
+}
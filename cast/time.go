@@ -0,0 +1,169 @@
+package cast
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeFor[time.Duration]()
+
+// DefaultTimeLayouts - форматы, которые пробуются при разборе строки во время,
+// если в Options.TimeLayouts ничего не задано
+var DefaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	time.DateTime,
+	time.DateOnly,
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// Пороги автоматического определения единицы Unix-метки по ее величине
+var (
+	unixMillisThreshold = big.NewInt(1e11)
+	unixMicrosThreshold = big.NewInt(1e14)
+	unixNanosThreshold  = big.NewInt(1e17)
+	nanosPerSecond      = big.NewInt(int64(time.Second))
+)
+
+func (o Options) timeLayouts() []string {
+	if len(o.TimeLayouts) > 0 {
+		return o.TimeLayouts
+	}
+	return DefaultTimeLayouts
+}
+
+func (o Options) location() *time.Location {
+	if o.Location != nil {
+		return o.Location
+	}
+	return time.UTC
+}
+
+// toTime преобразует строку или Unix-метку во время
+func (c conversion) toTime(sv reflect.Value) (time.Time, error) {
+	fail := func(err error) (time.Time, error) {
+		return time.Time{}, &ConversionError{Value: sv.Interface(), Target: timeType, Err: err}
+	}
+
+	if sv.Kind() == reflect.String {
+		s := strings.TrimSpace(sv.String())
+		for _, layout := range c.opts.timeLayouts() {
+			if t, err := time.ParseInLocation(layout, s, c.opts.location()); err == nil {
+				return t, nil
+			}
+		}
+		if !decimalPattern.MatchString(s) {
+			return fail(fmt.Errorf("%w: строка %q не соответствует ни одному формату времени", ErrFormat, s))
+		}
+	}
+
+	r, err := toRat(sv, Strict)
+	if err != nil {
+		return fail(err)
+	}
+
+	unit := c.opts.UnixUnit
+	if unit <= 0 {
+		unit = detectUnixUnit(r)
+	}
+
+	// Метка переводится в наносекунды через big.Int, чтобы не переполнить int64
+	nanos := new(big.Rat).Mul(r, new(big.Rat).SetInt64(int64(unit)))
+	total := new(big.Int).Quo(nanos.Num(), nanos.Denom())
+	sec, nsec := new(big.Int).DivMod(total, nanosPerSecond, new(big.Int))
+	if !sec.IsInt64() {
+		return fail(ErrOverflow)
+	}
+	return time.Unix(sec.Int64(), nsec.Int64()).In(c.opts.location()), nil
+}
+
+// detectUnixUnit определяет единицу Unix-метки по ее величине:
+// секунды, миллисекунды, микросекунды или наносекунды
+func detectUnixUnit(r *big.Rat) time.Duration {
+	abs := new(big.Int).Abs(new(big.Int).Quo(r.Num(), r.Denom()))
+	switch {
+	case abs.Cmp(unixMillisThreshold) < 0:
+		return time.Second
+	case abs.Cmp(unixMicrosThreshold) < 0:
+		return time.Millisecond
+	case abs.Cmp(unixNanosThreshold) < 0:
+		return time.Microsecond
+	default:
+		return time.Nanosecond
+	}
+}
+
+// fromTime преобразует время в строку по первому формату или в Unix-метку.
+// Строка форматируется в часовом поясе самого значения, если Options.Location не задан явно
+func (c conversion) fromTime(t time.Time, target reflect.Type) (any, error) {
+	if target.Kind() == reflect.String {
+		if c.opts.Location != nil {
+			t = t.In(c.opts.Location)
+		}
+		s := t.Format(c.opts.timeLayouts()[0])
+		return reflect.ValueOf(s).Convert(target).Interface(), nil
+	}
+
+	unit := c.opts.UnixUnit
+	if unit <= 0 {
+		unit = time.Second
+	}
+	nanos := new(big.Int).Mul(big.NewInt(t.Unix()), nanosPerSecond)
+	nanos.Add(nanos, big.NewInt(int64(t.Nanosecond())))
+	r := new(big.Rat).SetFrac(nanos, big.NewInt(int64(unit)))
+
+	result, err := ratToNumber(r, target, c.opts.Mode)
+	if err != nil {
+		return nil, &ConversionError{Value: t, Target: target, Err: err}
+	}
+	return result.Interface(), nil
+}
+
+// toDuration преобразует строку вида "1h30m" или число в единицах
+// Options.DurationUnit (по умолчанию секунд) в time.Duration
+func (c conversion) toDuration(sv reflect.Value) (time.Duration, error) {
+	fail := func(err error) (time.Duration, error) {
+		return 0, &ConversionError{Value: sv.Interface(), Target: durationType, Err: err}
+	}
+
+	if sv.Kind() == reflect.String {
+		s := strings.TrimSpace(sv.String())
+		d, err := time.ParseDuration(s)
+		if err == nil {
+			return d, nil
+		}
+		if !decimalPattern.MatchString(s) {
+			return fail(fmt.Errorf("%w: %v", ErrFormat, err))
+		}
+	}
+
+	r, err := toRat(sv, Strict)
+	if err != nil {
+		return fail(err)
+	}
+	unit := c.opts.DurationUnit
+	if unit <= 0 {
+		unit = time.Second
+	}
+	nanos := new(big.Rat).Mul(r, new(big.Rat).SetInt64(int64(unit)))
+	result, err := ratToNumber(nanos, durationType, c.opts.Mode)
+	if err != nil {
+		return fail(err)
+	}
+	return time.Duration(result.Int()), nil
+}
+
+// fromDuration преобразует time.Duration в число единиц Options.DurationUnit.
+// Вызывается только при заданной единице, иначе Duration приводится как int64 наносекунд
+func (c conversion) fromDuration(d time.Duration, target reflect.Type) (any, error) {
+	r := new(big.Rat).SetFrac64(int64(d), int64(c.opts.DurationUnit))
+	result, err := ratToNumber(r, target, c.opts.Mode)
+	if err != nil {
+		return nil, &ConversionError{Value: d, Target: target, Err: err}
+	}
+	return result.Interface(), nil
+}
@@ -0,0 +1,168 @@
+package cast
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTo_TimeFromString(t *testing.T) {
+	want := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+
+	for _, s := range []string{"2024-03-15T10:30:00Z", "2024-03-15 10:30:00", "2024-03-15T10:30:00"} {
+		got, err := To[time.Time](s)
+		if err != nil {
+			t.Fatalf("ошибка для %q: %v", s, err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("для %q ожидалось %v, получено %v", s, want, got)
+		}
+	}
+
+	if _, err := To[time.Time]("вчера"); !errors.Is(err, ErrFormat) {
+		t.Errorf("ожидалась ErrFormat, получено %v", err)
+	}
+}
+
+func TestTo_TimeFromUnix(t *testing.T) {
+	want := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input any
+	}{
+		{"seconds", want.Unix()},
+		{"millis", want.UnixMilli()},
+		{"micros", want.UnixMicro()},
+		{"nanos", want.UnixNano()},
+		{"float seconds", float64(want.Unix())},
+		{"numeric string", "1710498600"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := To[time.Time](tt.input)
+			if err != nil {
+				t.Fatalf("ошибка: %v", err)
+			}
+			if !got.Equal(want) {
+				t.Errorf("ожидалось %v, получено %v", want, got)
+			}
+		})
+	}
+}
+
+func TestToWithOptions_TimeLayoutsAndLocation(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	opts := Options{
+		TimeLayouts: []string{"02.01.2006 15:04"},
+		Location:    moscow,
+		UnixUnit:    time.Millisecond,
+	}
+
+	got, err := ToWithOptions[time.Time]("15.03.2024 13:30", opts)
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if !got.Equal(time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)) {
+		t.Errorf("получено %v", got)
+	}
+
+	s, err := ToWithOptions[string](got, opts)
+	if err != nil || s != "15.03.2024 13:30" {
+		t.Errorf("ожидалось '15.03.2024 13:30', получено %q, %v", s, err)
+	}
+
+	ms, err := ToWithOptions[int64](got, opts)
+	if err != nil || ms != got.UnixMilli() {
+		t.Errorf("ожидалось %d, получено %d, %v", got.UnixMilli(), ms, err)
+	}
+
+	// Явная единица отключает автоопределение
+	got, err = ToWithOptions[time.Time](1000, opts)
+	if err != nil || got.Unix() != 1 {
+		t.Errorf("ожидалась 1 секунда, получено %v, %v", got, err)
+	}
+}
+
+func TestTo_TimeKeepsOffset(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	value := time.Date(2024, 3, 15, 13, 30, 0, 0, moscow)
+
+	s, err := To[string](value)
+	if err != nil || s != "2024-03-15T13:30:00+03:00" {
+		t.Errorf("ожидалось смещение +03:00, получено %q, %v", s, err)
+	}
+
+	// Явный Location переводит время в указанный пояс
+	s, err = ToWithOptions[string](value, Options{Location: time.UTC})
+	if err != nil || s != "2024-03-15T10:30:00Z" {
+		t.Errorf("ожидалось время в UTC, получено %q, %v", s, err)
+	}
+}
+
+func TestTo_Duration(t *testing.T) {
+	tests := []struct {
+		name  string
+		input any
+		want  time.Duration
+	}{
+		{"go syntax", "1h30m", 90 * time.Minute},
+		{"integer seconds", 90, 90 * time.Second},
+		{"float seconds", 1.5, 1500 * time.Millisecond},
+		{"numeric string", "45", 45 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := To[time.Duration](tt.input)
+			if err != nil {
+				t.Fatalf("ошибка: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ожидалось %v, получено %v", tt.want, got)
+			}
+		})
+	}
+
+	// Без DurationUnit Duration в число - наносекунды, как при обычном приведении
+	nanos, err := To[int64](time.Second)
+	if err != nil || nanos != 1e9 {
+		t.Errorf("ожидалось 1e9, получено %v, %v", nanos, err)
+	}
+	if ms, err := ToWithOptions[int](250*time.Millisecond, Options{Mode: Strict}); err != nil || ms != 250e6 {
+		t.Errorf("ожидалось 250e6, получено %v, %v", ms, err)
+	}
+
+	seconds := Options{Mode: Strict, DurationUnit: time.Second}
+	secs, err := ToWithOptions[float64](1500*time.Millisecond, seconds)
+	if err != nil || secs != 1.5 {
+		t.Errorf("ожидалось 1.5, получено %v, %v", secs, err)
+	}
+	if _, err := ToWithOptions[int](1500*time.Millisecond, seconds); !errors.Is(err, ErrPrecisionLoss) {
+		t.Errorf("ожидалась ErrPrecisionLoss, получено %v", err)
+	}
+
+	millis := Options{DurationUnit: time.Millisecond}
+	if d, err := ToWithOptions[time.Duration](250, millis); err != nil || d != 250*time.Millisecond {
+		t.Errorf("ожидалось 250ms, получено %v, %v", d, err)
+	}
+	if ms, err := ToWithOptions[int64](2*time.Second, millis); err != nil || ms != 2000 {
+		t.Errorf("ожидалось 2000, получено %v, %v", ms, err)
+	}
+}
+
+func TestTo_TimeField(t *testing.T) {
+	type event struct {
+		At      time.Time     `json:"at"`
+		Timeout time.Duration `json:"timeout"`
+	}
+
+	got, err := To[event](map[string]any{"at": float64(1710498600), "timeout": "2s"})
+	if err != nil {
+		t.Fatalf("ошибка: %v", err)
+	}
+	if got.At.Unix() != 1710498600 || got.Timeout != 2*time.Second {
+		t.Errorf("получено %+v", got)
+	}
+}
@@ -1,6 +1,7 @@
 package complex
 
 import (
+	"cmp"
 	"fmt"
 	"math"
 )
@@ -115,3 +116,28 @@ func (c Complex) Sqrt() Complex {
 	r, theta := c.Polar()
 	return FromPolar(math.Sqrt(r), theta/2)
 }
+
+// Zero возвращает 0+0i
+func (Complex) Zero() Complex {
+	return Complex{}
+}
+
+// One возвращает 1+0i
+func (Complex) One() Complex {
+	return Complex{Real: 1}
+}
+
+// IsZero проверяет, равны ли нулю обе части числа
+func (c Complex) IsZero() bool {
+	return c.Real == 0 && c.Imag == 0
+}
+
+// Compare сравнивает числа лексикографически: сначала вещественные части, затем мнимые.
+// Комплексные числа не упорядочены алгебраически, порядок нужен для сортировки
+// и точной проверки равенства в обобщенных алгоритмах
+func (c Complex) Compare(other Complex) int {
+	if r := cmp.Compare(c.Real, other.Real); r != 0 {
+		return r
+	}
+	return cmp.Compare(c.Imag, other.Imag)
+}
@@ -0,0 +1,68 @@
+package complex
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// jsonComplex - представление числа в JSON
+type jsonComplex struct {
+	Real float64 `json:"real"`
+	Imag float64 `json:"imag"`
+}
+
+// MarshalJSON реализует интерфейс json.Marshaler: {"real":3,"imag":-4}
+func (c Complex) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonComplex(c))
+}
+
+// UnmarshalJSON реализует интерфейс json.Unmarshaler.
+// Принимается объект {"real":3,"imag":-4}, строка "3-4i" или вещественное число
+func (c *Complex) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case string(data) == "null":
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		parsed, err := Parse(s)
+		if err != nil {
+			return err
+		}
+		*c = parsed
+		return nil
+	case len(data) > 0 && data[0] == '{':
+		var raw jsonComplex
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		*c = Complex(raw)
+		return nil
+	default:
+		var re float64
+		if err := json.Unmarshal(data, &re); err != nil {
+			return err
+		}
+		*c = Complex{Real: re}
+		return nil
+	}
+}
+
+// MarshalText реализует интерфейс encoding.TextMarshaler в кратчайшей точной записи ("3-4i")
+func (c Complex) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%g", c)), nil
+}
+
+// UnmarshalText реализует интерфейс encoding.TextUnmarshaler
+func (c *Complex) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
@@ -0,0 +1,74 @@
+package complex
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse разбирает комплексное число в виде "3-4i", "(3-4i)", "2.5i", "-i", "1+i" или "5".
+// Части записываются как в strconv.ParseFloat, пробелы допускаются
+func Parse(s string) (Complex, error) {
+	text := strings.Join(strings.Fields(s), "")
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "("), ")")
+
+	// Мнимая единица без коэффициента: "i", "-i", "1+i"
+	if strings.HasSuffix(text, "i") {
+		body := text[:len(text)-1]
+		if body == "" || strings.HasSuffix(body, "+") || strings.HasSuffix(body, "-") {
+			text = body + "1i"
+		}
+	}
+
+	value, err := strconv.ParseComplex(text, 128)
+	if err != nil {
+		return Complex{}, fmt.Errorf("неверная запись комплексного числа: %q", s)
+	}
+	return FromComplex128(value), nil
+}
+
+// Format реализует fmt.Formatter. Глаголы 'e', 'E', 'f', 'F', 'g', 'G' форматируют
+// обе части с заданной точностью ("%.2f" -> "3.00-4.00i"), флаг '+' добавляет знак
+// вещественной части. '%v' и '%s' выводят String(), ширина выравнивает всю запись
+func (c Complex) Format(f fmt.State, verb rune) {
+	var s string
+	switch verb {
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		precision, ok := f.Precision()
+		if !ok {
+			precision = -1
+			if verb != 'g' && verb != 'G' {
+				precision = 6
+			}
+		}
+		format := byte(verb)
+		if format == 'F' {
+			format = 'f'
+		}
+
+		re := strconv.FormatFloat(c.Real, format, precision, 64)
+		if f.Flag('+') && re[0] != '-' && re[0] != '+' {
+			re = "+" + re
+		}
+		im := strconv.FormatFloat(c.Imag, format, precision, 64)
+		if im[0] != '-' && im[0] != '+' {
+			im = "+" + im
+		}
+		s = re + im + "i"
+	case 'v', 's':
+		s = c.String()
+	default:
+		fmt.Fprintf(f, "%%!%c(complex.Complex=%s)", verb, c.String())
+		return
+	}
+
+	if width, ok := f.Width(); ok && width > len(s) {
+		pad := strings.Repeat(" ", width-len(s))
+		if f.Flag('-') {
+			s += pad
+		} else {
+			s = pad + s
+		}
+	}
+	fmt.Fprint(f, s)
+}
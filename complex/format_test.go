@@ -0,0 +1,87 @@
+package complex
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Complex
+	}{
+		{"3-4i", New(3, -4)},
+		{"(3-4i)", New(3, -4)},
+		{"3 - 4i", New(3, -4)},
+		{"2.5i", New(0, 2.5)},
+		{"-i", New(0, -1)},
+		{"i", New(0, 1)},
+		{"1+i", New(1, 1)},
+		{"5", New(5, 0)},
+		{"1e-3+2E+2i", New(0.001, 200)},
+		{"3.000000-4.000000i", New(3, -4)},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.input)
+		if err != nil || got != tt.want {
+			t.Errorf("Parse(%q) = %v, %v; want %v", tt.input, got, err, tt.want)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "3-4j", "1+2i+3"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) expected error", input)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	c := New(3, -4.5)
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%v", "3.000000-4.500000i"},
+		{"%s", "3.000000-4.500000i"},
+		{"%.2f", "3.00-4.50i"},
+		{"%g", "3-4.5i"},
+		{"%+g", "+3-4.5i"},
+		{"%.1e", "3.0e+00-4.5e+00i"},
+		{"%10g", "    3-4.5i"},
+		{"%-10g|", "3-4.5i    |"},
+		{"%d", "%!d(complex.Complex=3.000000-4.500000i)"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, c); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	data, err := json.Marshal(New(3, -4))
+	if err != nil || string(data) != `{"real":3,"imag":-4}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+
+	for input, want := range map[string]Complex{
+		`{"real":3,"imag":-4}`: New(3, -4),
+		`"1+2i"`:               New(1, 2),
+		`2.5`:                  New(2.5, 0),
+	} {
+		var c Complex
+		if err := json.Unmarshal([]byte(input), &c); err != nil || c != want {
+			t.Errorf("Unmarshal(%s) = %v, %v; want %v", input, c, err, want)
+		}
+	}
+
+	text, err := New(0.1, 2).MarshalText()
+	if err != nil || string(text) != "0.1+2i" {
+		t.Errorf("MarshalText() = %s, %v", text, err)
+	}
+	var back Complex
+	if err := back.UnmarshalText(text); err != nil || back != New(0.1, 2) {
+		t.Errorf("UnmarshalText() = %v, %v", back, err)
+	}
+}
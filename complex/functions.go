@@ -0,0 +1,119 @@
+package complex
+
+import (
+	"math/cmplx"
+)
+
+// Функции используют math/cmplx, поэтому разрезы ветвей соответствуют C99 (ISO/IEC 9899, приложение G):
+// Log, Sqrt и Pow - вдоль отрицательной вещественной полуоси, Asin и Acos - вдоль (-∞, -1] и [1, +∞),
+// Atan - вдоль мнимой оси вне (-i, i). Значение на разрезе определяется знаком нулевой мнимой части
+
+// FromComplex128 создает число из встроенного типа complex128
+func FromComplex128(value complex128) Complex {
+	return Complex{Real: real(value), Imag: imag(value)}
+}
+
+// Complex128 преобразует число во встроенный тип complex128
+func (c Complex) Complex128() complex128 {
+	return complex(c.Real, c.Imag)
+}
+
+// Abs возвращает модуль числа; в отличие от Magnitude не переполняется для больших частей
+func (c Complex) Abs() float64 {
+	return cmplx.Abs(c.Complex128())
+}
+
+// Arg возвращает аргумент (фазу) числа в диапазоне [-π, π]
+func (c Complex) Arg() float64 {
+	return cmplx.Phase(c.Complex128())
+}
+
+// Exp возвращает e^c
+func (c Complex) Exp() Complex {
+	return FromComplex128(cmplx.Exp(c.Complex128()))
+}
+
+// Log возвращает главное значение натурального логарифма
+func (c Complex) Log() Complex {
+	return FromComplex128(cmplx.Log(c.Complex128()))
+}
+
+// Log10 возвращает главное значение десятичного логарифма
+func (c Complex) Log10() Complex {
+	return FromComplex128(cmplx.Log10(c.Complex128()))
+}
+
+// Pow возвращает главное значение c^exponent = e^(exponent * Log c); 0^0 = 1
+func (c Complex) Pow(exponent Complex) Complex {
+	return FromComplex128(cmplx.Pow(c.Complex128(), exponent.Complex128()))
+}
+
+// Sin возвращает синус
+func (c Complex) Sin() Complex {
+	return FromComplex128(cmplx.Sin(c.Complex128()))
+}
+
+// Cos возвращает косинус
+func (c Complex) Cos() Complex {
+	return FromComplex128(cmplx.Cos(c.Complex128()))
+}
+
+// Tan возвращает тангенс
+func (c Complex) Tan() Complex {
+	return FromComplex128(cmplx.Tan(c.Complex128()))
+}
+
+// Sinh возвращает гиперболический синус
+func (c Complex) Sinh() Complex {
+	return FromComplex128(cmplx.Sinh(c.Complex128()))
+}
+
+// Cosh возвращает гиперболический косинус
+func (c Complex) Cosh() Complex {
+	return FromComplex128(cmplx.Cosh(c.Complex128()))
+}
+
+// Tanh возвращает гиперболический тангенс
+func (c Complex) Tanh() Complex {
+	return FromComplex128(cmplx.Tanh(c.Complex128()))
+}
+
+// Asin возвращает главное значение арксинуса
+func (c Complex) Asin() Complex {
+	return FromComplex128(cmplx.Asin(c.Complex128()))
+}
+
+// Acos возвращает главное значение арккосинуса
+func (c Complex) Acos() Complex {
+	return FromComplex128(cmplx.Acos(c.Complex128()))
+}
+
+// Atan возвращает главное значение арктангенса
+func (c Complex) Atan() Complex {
+	return FromComplex128(cmplx.Atan(c.Complex128()))
+}
+
+// Asinh возвращает главное значение гиперболического арксинуса
+func (c Complex) Asinh() Complex {
+	return FromComplex128(cmplx.Asinh(c.Complex128()))
+}
+
+// Acosh возвращает главное значение гиперболического арккосинуса
+func (c Complex) Acosh() Complex {
+	return FromComplex128(cmplx.Acosh(c.Complex128()))
+}
+
+// Atanh возвращает главное значение гиперболического арктангенса
+func (c Complex) Atanh() Complex {
+	return FromComplex128(cmplx.Atanh(c.Complex128()))
+}
+
+// IsNaN сообщает, является ли одна из частей NaN (и ни одна не бесконечна)
+func (c Complex) IsNaN() bool {
+	return cmplx.IsNaN(c.Complex128())
+}
+
+// IsInf сообщает, является ли одна из частей бесконечной
+func (c Complex) IsInf() bool {
+	return cmplx.IsInf(c.Complex128())
+}
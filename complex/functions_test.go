@@ -0,0 +1,86 @@
+package complex
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+const eps = 1e-12
+
+func TestConversions(t *testing.T) {
+	c := FromComplex128(3 - 4i)
+	if c != New(3, -4) || c.Complex128() != 3-4i {
+		t.Errorf("conversion failed: %v", c)
+	}
+	if c.Abs() != 5 || math.Abs(c.Arg()-math.Atan2(-4, 3)) > eps {
+		t.Errorf("Abs() = %v, Arg() = %v", c.Abs(), c.Arg())
+	}
+}
+
+func TestElementaryFunctions(t *testing.T) {
+	z := New(0.5, -1.25)
+	tests := []struct {
+		name string
+		got  Complex
+		want complex128
+	}{
+		{"Exp", z.Exp(), cmplx.Exp(0.5 - 1.25i)},
+		{"Log", z.Log(), cmplx.Log(0.5 - 1.25i)},
+		{"Sin", z.Sin(), cmplx.Sin(0.5 - 1.25i)},
+		{"Cos", z.Cos(), cmplx.Cos(0.5 - 1.25i)},
+		{"Tan", z.Tan(), cmplx.Tan(0.5 - 1.25i)},
+		{"Sinh", z.Sinh(), cmplx.Sinh(0.5 - 1.25i)},
+		{"Cosh", z.Cosh(), cmplx.Cosh(0.5 - 1.25i)},
+		{"Tanh", z.Tanh(), cmplx.Tanh(0.5 - 1.25i)},
+		{"Asin", z.Asin(), cmplx.Asin(0.5 - 1.25i)},
+		{"Acos", z.Acos(), cmplx.Acos(0.5 - 1.25i)},
+		{"Atan", z.Atan(), cmplx.Atan(0.5 - 1.25i)},
+		{"Asinh", z.Asinh(), cmplx.Asinh(0.5 - 1.25i)},
+		{"Acosh", z.Acosh(), cmplx.Acosh(0.5 - 1.25i)},
+		{"Atanh", z.Atanh(), cmplx.Atanh(0.5 - 1.25i)},
+	}
+	for _, tt := range tests {
+		if !tt.got.Equals(FromComplex128(tt.want), eps) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	// Обратные функции действительно обращают прямые
+	if !z.Sin().Asin().Equals(z, eps) || !z.Tanh().Atanh().Equals(z, eps) || !z.Exp().Log().Equals(z, eps) {
+		t.Errorf("inverse functions do not round trip for %v", z)
+	}
+}
+
+func TestPowAndIdentities(t *testing.T) {
+	// e^(iπ) = -1
+	if got := New(0, math.Pi).Exp(); !got.Equals(New(-1, 0), eps) {
+		t.Errorf("e^(iπ) = %v", got)
+	}
+	// i^i = e^(-π/2)
+	i := New(0, 1)
+	if got := i.Pow(i); !got.Equals(New(math.Exp(-math.Pi/2), 0), eps) {
+		t.Errorf("i^i = %v", got)
+	}
+	if got := New(0, 0).Pow(New(0, 0)); got != New(1, 0) {
+		t.Errorf("0^0 = %v", got)
+	}
+}
+
+func TestBranchCuts(t *testing.T) {
+	// Log на отрицательной полуоси: знак нулевой мнимой части выбирает сторону разреза
+	above := New(-1, 0).Log()
+	below := New(-1, math.Copysign(0, -1)).Log()
+	if !above.Equals(New(0, math.Pi), eps) || !below.Equals(New(0, -math.Pi), eps) {
+		t.Errorf("Log(-1±0i) = %v, %v", above, below)
+	}
+
+	// Asin(2) лежит на разрезе [1, +∞) и вещественная часть равна π/2
+	if got := New(2, 0).Asin(); math.Abs(got.Real-math.Pi/2) > eps {
+		t.Errorf("Asin(2) = %v", got)
+	}
+
+	if !New(math.Inf(1), 0).IsInf() || !New(math.NaN(), 0).IsNaN() {
+		t.Errorf("IsInf/IsNaN returned wrong result")
+	}
+}
@@ -0,0 +1,74 @@
+package fft
+
+import (
+	"runtime"
+
+	"types/complex"
+)
+
+// Options задает параметры преобразования
+type Options struct {
+	// Workers - количество горутин для этапов бабочек. 0 или 1 - последовательно,
+	// отрицательное значение - runtime.GOMAXPROCS(0)
+	Workers int
+	// Threshold - минимальная длина преобразования для параллельного выполнения,
+	// по умолчанию DefaultParallelThreshold
+	Threshold int
+}
+
+// DefaultParallelThreshold - длина, начиная с которой параллельное выполнение окупает накладные расходы
+const DefaultParallelThreshold = 1 << 14
+
+// workers возвращает количество горутин для преобразования длины n
+func (o Options) workers(n int) int {
+	threshold := o.Threshold
+	if threshold <= 0 {
+		threshold = DefaultParallelThreshold
+	}
+	w := o.Workers
+	if w < 0 {
+		w = runtime.GOMAXPROCS(0)
+	}
+	if w <= 1 || n < threshold {
+		return 1
+	}
+	return w
+}
+
+// optionsOf возвращает переданные параметры или параметры по умолчанию
+func optionsOf(opts []Options) Options {
+	if len(opts) == 0 {
+		return Options{}
+	}
+	return opts[0]
+}
+
+// FFT вычисляет дискретное преобразование Фурье X_k = Σ x_n e^(-2πikn/N).
+// Для длин, равных степени двойки, используется алгоритм Кули-Тьюки (radix-2),
+// для остальных - алгоритм Блюстейна. Входной срез не изменяется
+func FFT(x []complex.Complex, opts ...Options) []complex.Complex {
+	return fromComplex128(transform(toComplex128(x), false, optionsOf(opts)))
+}
+
+// IFFT вычисляет обратное преобразование x_n = (1/N) Σ X_k e^(2πikn/N)
+func IFFT(x []complex.Complex, opts ...Options) []complex.Complex {
+	return fromComplex128(inverse(toComplex128(x), optionsOf(opts)))
+}
+
+// toComplex128 копирует срез в complex128
+func toComplex128(x []complex.Complex) []complex128 {
+	result := make([]complex128, len(x))
+	for i, c := range x {
+		result[i] = c.Complex128()
+	}
+	return result
+}
+
+// fromComplex128 преобразует срез complex128 в []complex.Complex
+func fromComplex128(x []complex128) []complex.Complex {
+	result := make([]complex.Complex, len(x))
+	for i, c := range x {
+		result[i] = complex.FromComplex128(c)
+	}
+	return result
+}
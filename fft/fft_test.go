@@ -0,0 +1,169 @@
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"types/complex"
+)
+
+const eps = 1e-9
+
+// naiveDFT вычисляет преобразование по определению
+func naiveDFT(x []complex.Complex) []complex.Complex {
+	n := len(x)
+	result := make([]complex.Complex, n)
+	for k := range result {
+		var sum complex128
+		for j, v := range x {
+			sum += v.Complex128() * cmplx.Rect(1, -2*math.Pi*float64(k*j)/float64(n))
+		}
+		result[k] = complex.FromComplex128(sum)
+	}
+	return result
+}
+
+func randomSignal(n int, seed int64) []complex.Complex {
+	rng := rand.New(rand.NewSource(seed))
+	x := make([]complex.Complex, n)
+	for i := range x {
+		x[i] = complex.New(rng.Float64()*2-1, rng.Float64()*2-1)
+	}
+	return x
+}
+
+func assertClose(t *testing.T, name string, got, want []complex.Complex) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: length %d, want %d", name, len(got), len(want))
+	}
+	for i := range got {
+		if !got[i].Equals(want[i], eps*float64(len(got)+1)) {
+			t.Fatalf("%s[%d] = %v, want %v", name, i, got[i], want[i])
+		}
+	}
+}
+
+func TestFFTMatchesDFT(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 7, 8, 12, 100, 256, 1000} {
+		x := randomSignal(n, int64(n))
+		assertClose(t, "FFT", FFT(x), naiveDFT(x))
+		assertClose(t, "IFFT(FFT)", IFFT(FFT(x)), x)
+	}
+	if FFT(nil) == nil || len(FFT(nil)) != 0 {
+		t.Errorf("FFT(nil) should be empty")
+	}
+}
+
+func TestFFTDoesNotModifyInput(t *testing.T) {
+	x := randomSignal(6, 1)
+	saved := append([]complex.Complex(nil), x...)
+	FFT(x)
+	IFFT(x)
+	for i := range x {
+		if x[i] != saved[i] {
+			t.Fatalf("input modified at %d", i)
+		}
+	}
+}
+
+func TestParallel(t *testing.T) {
+	opts := Options{Workers: 4, Threshold: 1}
+	for _, n := range []int{1024, 1500} {
+		x := randomSignal(n, 42)
+		assertClose(t, "parallel FFT", FFT(x, opts), FFT(x))
+	}
+	if got := (Options{Workers: -1}).workers(1 << 20); got < 1 {
+		t.Errorf("workers() = %d", got)
+	}
+	if got := (Options{Workers: 8}).workers(16); got != 1 {
+		t.Errorf("small transforms should run serially, got %d workers", got)
+	}
+}
+
+func TestRFFT(t *testing.T) {
+	for _, n := range []int{1, 2, 9, 16, 30} {
+		rng := rand.New(rand.NewSource(int64(n)))
+		x := make([]float64, n)
+		cx := make([]complex.Complex, n)
+		for i := range x {
+			x[i] = rng.NormFloat64()
+			cx[i] = complex.New(x[i], 0)
+		}
+
+		spectrum := RFFT(x)
+		assertClose(t, "RFFT", spectrum, FFT(cx)[:n/2+1])
+
+		back := IRFFT(spectrum, n)
+		for i := range x {
+			if math.Abs(back[i]-x[i]) > eps {
+				t.Fatalf("IRFFT(n=%d)[%d] = %v, want %v", n, i, back[i], x[i])
+			}
+		}
+	}
+}
+
+func TestIRFFTInvalidLength(t *testing.T) {
+	for _, tt := range []struct {
+		spectrum []complex.Complex
+		n        int
+		want     string
+	}{
+		{[]complex.Complex{complex.New(1, 0)}, 0, "длина сигнала n должна быть положительной"},
+		{[]complex.Complex{complex.New(1, 0)}, -1, "длина сигнала n должна быть положительной"},
+		{[]complex.Complex{complex.New(1, 0)}, 4, "длина спектра должна быть n/2+1"},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != tt.want {
+					t.Errorf("IRFFT(n=%d) panic = %v, want %q", tt.n, r, tt.want)
+				}
+			}()
+			IRFFT(tt.spectrum, tt.n)
+		}()
+	}
+}
+
+func TestConvolve(t *testing.T) {
+	got := ConvolveReal([]float64{1, 2, 3}, []float64{0, 1, 0.5})
+	want := []float64{0, 1, 2.5, 4, 1.5}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > eps {
+			t.Fatalf("ConvolveReal() = %v, want %v", got, want)
+		}
+	}
+
+	a := []complex.Complex{complex.New(1, 1), complex.New(0, 2)}
+	b := []complex.Complex{complex.New(2, 0), complex.New(1, -1), complex.New(0, 1)}
+	direct := make([]complex.Complex, len(a)+len(b)-1)
+	for i := range a {
+		for j := range b {
+			direct[i+j] = direct[i+j].Add(a[i].Multiply(b[j]))
+		}
+	}
+	assertClose(t, "Convolve", Convolve(a, b), direct)
+}
+
+func TestCorrelate(t *testing.T) {
+	// Сигнал, сдвинутый на 2 отсчета, дает максимум корреляции при сдвиге 2
+	b := []float64{1, 2, 3, 2, 1}
+	a := append([]float64{0, 0}, b...)
+	c := CorrelateReal(a, b)
+	best := 0
+	for i := range c {
+		if c[i] > c[best] {
+			best = i
+		}
+	}
+	if lag := best - (len(b) - 1); lag != 2 {
+		t.Errorf("peak lag = %d, want 2", lag)
+	}
+
+	x := []complex.Complex{complex.New(0, 1), complex.New(1, 0)}
+	// c_0 = Σ a_n conj(a_n) = |a|²
+	if got := Correlate(x, x)[len(x)-1]; !got.Equals(complex.New(2, 0), eps) {
+		t.Errorf("autocorrelation at lag 0 = %v, want 2", got)
+	}
+}
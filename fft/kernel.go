@@ -0,0 +1,200 @@
+package fft
+
+import (
+	"math"
+	"math/bits"
+	"math/cmplx"
+	"sync"
+)
+
+// Ядра преобразований работают с встроенным complex128; файл не импортирует
+// пакет types/complex, чтобы была доступна встроенная функция complex
+
+// transform выполняет преобразование на месте и возвращает результат
+func transform(x []complex128, inv bool, o Options) []complex128 {
+	n := len(x)
+	switch {
+	case n <= 1:
+		return x
+	case n&(n-1) == 0:
+		radix2(x, inv, o.workers(n))
+		return x
+	default:
+		return bluestein(x, inv, o)
+	}
+}
+
+// inverse выполняет обратное преобразование с нормировкой 1/N
+func inverse(x []complex128, o Options) []complex128 {
+	x = transform(x, true, o)
+	scale := complex(1/float64(len(x)), 0)
+	for i := range x {
+		x[i] *= scale
+	}
+	return x
+}
+
+// radix2 выполняет итеративное преобразование Кули-Тьюки для длины 2^k на месте
+func radix2(x []complex128, inv bool, workers int) {
+	n := len(x)
+	shift := 64 - bits.TrailingZeros(uint(n))
+	for i := range x {
+		if j := int(bits.Reverse64(uint64(i)) >> shift); j > i {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+
+	twiddles := twiddleFactors(n, inv)
+	for size := 2; size <= n; size <<= 1 {
+		half, step := size/2, n/size
+		butterflies := func(from, to int) {
+			for b := from; b < to; b++ {
+				j := b % half
+				i := (b/half)*size + j
+				t := twiddles[j*step] * x[i+half]
+				x[i+half] = x[i] - t
+				x[i] += t
+			}
+		}
+		parallel(n/2, workers, butterflies)
+	}
+}
+
+// twiddleFactors возвращает e^(∓2πik/n) для k < n/2
+func twiddleFactors(n int, inv bool) []complex128 {
+	sign := -1.0
+	if inv {
+		sign = 1
+	}
+	w := make([]complex128, n/2)
+	for k := range w {
+		w[k] = cmplx.Rect(1, sign*2*math.Pi*float64(k)/float64(n))
+	}
+	return w
+}
+
+// bluestein сводит преобразование произвольной длины к свертке длины 2^k:
+// X_k = w_k Σ (x_n w_n) conj(w_{k-n}), где w_k = e^(∓iπk²/N)
+func bluestein(x []complex128, inv bool, o Options) []complex128 {
+	n := len(x)
+	m := 1 << bits.Len(uint(2*n-2))
+
+	sign := -1.0
+	if inv {
+		sign = 1
+	}
+	chirp := make([]complex128, n)
+	for k := range chirp {
+		// k² mod 2N сохраняет точность угла для больших k
+		kk := (uint64(k) * uint64(k)) % uint64(2*n)
+		chirp[k] = cmplx.Rect(1, sign*math.Pi*float64(kk)/float64(n))
+	}
+
+	a := make([]complex128, m)
+	b := make([]complex128, m)
+	for k := 0; k < n; k++ {
+		a[k] = x[k] * chirp[k]
+	}
+	b[0] = cmplx.Conj(chirp[0])
+	for k := 1; k < n; k++ {
+		b[k] = cmplx.Conj(chirp[k])
+		b[m-k] = b[k]
+	}
+
+	workers := o.workers(m)
+	radix2(a, false, workers)
+	radix2(b, false, workers)
+	for i := range a {
+		a[i] *= b[i]
+	}
+	radix2(a, true, workers)
+
+	scale := complex(1/float64(m), 0)
+	result := x[:n]
+	for k := range result {
+		result[k] = a[k] * scale * chirp[k]
+	}
+	return result
+}
+
+// parallel выполняет fn на отрезках [0, n), разделенных между workers горутинами
+func parallel(n, workers int, fn func(from, to int)) {
+	if workers <= 1 {
+		fn(0, n)
+		return
+	}
+
+	var wg sync.WaitGroup
+	chunk := (n + workers - 1) / workers
+	for from := 0; from < n; from += chunk {
+		to := min(from+chunk, n)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn(from, to)
+		}()
+	}
+	wg.Wait()
+}
+
+// rfft вычисляет N/2+1 коэффициентов преобразования вещественного сигнала
+func rfft(x []float64, o Options) []complex128 {
+	n := len(x)
+	if n == 0 {
+		return nil
+	}
+	if n%2 != 0 {
+		full := make([]complex128, n)
+		for i, v := range x {
+			full[i] = complex(v, 0)
+		}
+		return transform(full, false, o)[:n/2+1]
+	}
+
+	// Упаковываем четные и нечетные отсчеты в z_k = x_2k + i x_2k+1
+	half := n / 2
+	z := make([]complex128, half)
+	for k := range z {
+		z[k] = complex(x[2*k], x[2*k+1])
+	}
+	z = transform(z, false, o)
+
+	// X_k = E_k + e^(-2πik/N) O_k, где E_k = (Z_k + conj Z_{M-k})/2, O_k = (Z_k - conj Z_{M-k})/(2i)
+	result := make([]complex128, half+1)
+	for k := 0; k <= half; k++ {
+		zk := z[k%half]
+		zc := cmplx.Conj(z[(half-k)%half])
+		even := (zk + zc) / 2
+		odd := (zk - zc) / 2i
+		result[k] = even + cmplx.Rect(1, -2*math.Pi*float64(k)/float64(n))*odd
+	}
+	return result
+}
+
+// convolve вычисляет линейную свертку через преобразования длины 2^k
+func convolve(a, b []complex128, o Options) []complex128 {
+	n := len(a) + len(b) - 1
+	m := 1 << bits.Len(uint(n-1))
+
+	fa := make([]complex128, m)
+	fb := make([]complex128, m)
+	copy(fa, a)
+	copy(fb, b)
+
+	workers := o.workers(m)
+	radix2(fa, false, workers)
+	radix2(fb, false, workers)
+	for i := range fa {
+		fa[i] *= fb[i]
+	}
+	return inverse(fa, o)[:n]
+}
+
+// realToComplex128 переводит вещественный срез в complex128
+func realToComplex128(x []float64) []complex128 {
+	result := make([]complex128, len(x))
+	for i, v := range x {
+		result[i] = complex(v, 0)
+	}
+	return result
+}
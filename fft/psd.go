@@ -0,0 +1,89 @@
+package fft
+
+import (
+	"errors"
+	"math/cmplx"
+)
+
+// Frequencies возвращает частоты N/2+1 коэффициентов RFFT для сигнала длины n
+func Frequencies(n int, sampleRate float64) []float64 {
+	if n <= 0 {
+		return nil
+	}
+	freqs := make([]float64, n/2+1)
+	for k := range freqs {
+		freqs[k] = float64(k) * sampleRate / float64(n)
+	}
+	return freqs
+}
+
+// Periodogram оценивает одностороннюю спектральную плотность мощности сигнала
+// (единицы сигнала² на Гц). window может быть nil (прямоугольное окно);
+// нормировка на Σw² сохраняет мощность при любом окне
+func Periodogram(x []float64, sampleRate float64, window []float64, opts ...Options) (freqs, psd []float64, err error) {
+	if len(x) == 0 {
+		return nil, nil, errors.New("сигнал не может быть пустым")
+	}
+	if sampleRate <= 0 {
+		return nil, nil, errors.New("частота дискретизации должна быть положительной")
+	}
+	if window != nil && len(window) != len(x) {
+		return nil, nil, errors.New("длины сигнала и окна должны совпадать")
+	}
+	return Frequencies(len(x), sampleRate), periodogram(x, sampleRate, window, optionsOf(opts)), nil
+}
+
+// Welch оценивает спектральную плотность мощности методом Уэлча: сигнал делится
+// на отрезки длины segment с перекрытием overlap, к каждому применяется окно
+// Ханна, периодограммы отрезков усредняются
+func Welch(x []float64, sampleRate float64, segment, overlap int, opts ...Options) (freqs, psd []float64, err error) {
+	if segment <= 0 || segment > len(x) {
+		return nil, nil, errors.New("длина отрезка должна быть положительной и не больше длины сигнала")
+	}
+	if overlap < 0 || overlap >= segment {
+		return nil, nil, errors.New("перекрытие должно быть в диапазоне [0, segment)")
+	}
+	if sampleRate <= 0 {
+		return nil, nil, errors.New("частота дискретизации должна быть положительной")
+	}
+
+	o := optionsOf(opts)
+	window := Hann(segment)
+	psd = make([]float64, segment/2+1)
+	count := 0
+	for start := 0; start+segment <= len(x); start += segment - overlap {
+		for k, p := range periodogram(x[start:start+segment], sampleRate, window, o) {
+			psd[k] += p
+		}
+		count++
+	}
+	for k := range psd {
+		psd[k] /= float64(count)
+	}
+	return Frequencies(segment, sampleRate), psd, nil
+}
+
+// periodogram вычисляет одностороннюю СПМ одного отрезка
+func periodogram(x []float64, sampleRate float64, window []float64, o Options) []float64 {
+	n := len(x)
+	energy := float64(n)
+	if window != nil {
+		x = ApplyWindow(x, window)
+		energy = 0
+		for _, w := range window {
+			energy += w * w
+		}
+	}
+
+	spectrum := rfft(x, o)
+	psd := make([]float64, len(spectrum))
+	for k, c := range spectrum {
+		power := cmplx.Abs(c)
+		psd[k] = power * power / (sampleRate * energy)
+		// Отрицательные частоты складываются с положительными, кроме нулевой и частоты Найквиста
+		if k != 0 && !(n%2 == 0 && k == n/2) {
+			psd[k] *= 2
+		}
+	}
+	return psd
+}
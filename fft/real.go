@@ -0,0 +1,82 @@
+package fft
+
+import (
+	"math/cmplx"
+
+	"types/complex"
+)
+
+// RFFT вычисляет преобразование вещественного сигнала и возвращает N/2+1
+// неотрицательных частот; остальные коэффициенты - сопряженные к ним.
+// Для четных N используется преобразование половинной длины
+func RFFT(x []float64, opts ...Options) []complex.Complex {
+	return fromComplex128(rfft(x, optionsOf(opts)))
+}
+
+// IRFFT восстанавливает вещественный сигнал длины n по N/2+1 коэффициентам RFFT
+func IRFFT(spectrum []complex.Complex, n int, opts ...Options) []float64 {
+	if n <= 0 {
+		panic("длина сигнала n должна быть положительной")
+	}
+	if len(spectrum) != n/2+1 {
+		panic("длина спектра должна быть n/2+1")
+	}
+
+	full := make([]complex128, n)
+	for k := range spectrum {
+		full[k] = spectrum[k].Complex128()
+	}
+	for k := n/2 + 1; k < n; k++ {
+		full[k] = cmplx.Conj(full[n-k])
+	}
+
+	full = inverse(full, optionsOf(opts))
+	result := make([]float64, n)
+	for i, c := range full {
+		result[i] = real(c)
+	}
+	return result
+}
+
+// Convolve вычисляет линейную свертку (a * b)_k = Σ a_i b_(k-i) длины len(a)+len(b)-1
+func Convolve(a, b []complex.Complex, opts ...Options) []complex.Complex {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	return fromComplex128(convolve(toComplex128(a), toComplex128(b), optionsOf(opts)))
+}
+
+// ConvolveReal вычисляет линейную свертку вещественных сигналов
+func ConvolveReal(a, b []float64, opts ...Options) []float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	result := convolve(realToComplex128(a), realToComplex128(b), optionsOf(opts))
+	out := make([]float64, len(result))
+	for i, c := range result {
+		out[i] = real(c)
+	}
+	return out
+}
+
+// Correlate вычисляет взаимную корреляцию c_k = Σ a_(n+k) conj(b_n) для всех сдвигов
+// k от -(len(b)-1) до len(a)-1; элемент с индексом i соответствует сдвигу i-(len(b)-1)
+func Correlate(a, b []complex.Complex, opts ...Options) []complex.Complex {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	reversed := make([]complex128, len(b))
+	for i, c := range b {
+		reversed[len(b)-1-i] = cmplx.Conj(c.Complex128())
+	}
+	return fromComplex128(convolve(toComplex128(a), reversed, optionsOf(opts)))
+}
+
+// CorrelateReal вычисляет взаимную корреляцию вещественных сигналов (порядок сдвигов как в Correlate)
+func CorrelateReal(a, b []float64, opts ...Options) []float64 {
+	reversed := make([]float64, len(b))
+	for i, v := range b {
+		reversed[len(b)-1-i] = v
+	}
+	return ConvolveReal(a, reversed, opts...)
+}
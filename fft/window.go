@@ -0,0 +1,50 @@
+package fft
+
+import (
+	"math"
+)
+
+// Hann возвращает симметричное окно Ханна длины n: 0.5 - 0.5 cos(2πi/(n-1))
+func Hann(n int) []float64 {
+	return cosineWindow(n, 0.5, 0.5, 0)
+}
+
+// Hamming возвращает симметричное окно Хэмминга длины n: 0.54 - 0.46 cos(2πi/(n-1))
+func Hamming(n int) []float64 {
+	return cosineWindow(n, 0.54, 0.46, 0)
+}
+
+// Blackman возвращает симметричное окно Блэкмана длины n:
+// 0.42 - 0.5 cos(2πi/(n-1)) + 0.08 cos(4πi/(n-1))
+func Blackman(n int) []float64 {
+	return cosineWindow(n, 0.42, 0.5, 0.08)
+}
+
+// ApplyWindow возвращает поэлементное произведение сигнала и окна
+func ApplyWindow(x, window []float64) []float64 {
+	if len(x) != len(window) {
+		panic("длины сигнала и окна должны совпадать")
+	}
+	result := make([]float64, len(x))
+	for i := range x {
+		result[i] = x[i] * window[i]
+	}
+	return result
+}
+
+// cosineWindow строит окно a0 - a1 cos(2πi/(n-1)) + a2 cos(4πi/(n-1))
+func cosineWindow(n int, a0, a1, a2 float64) []float64 {
+	if n <= 0 {
+		return nil
+	}
+	w := make([]float64, n)
+	if n == 1 {
+		w[0] = 1
+		return w
+	}
+	for i := range w {
+		phase := 2 * math.Pi * float64(i) / float64(n-1)
+		w[i] = a0 - a1*math.Cos(phase) + a2*math.Cos(2*phase)
+	}
+	return w
+}
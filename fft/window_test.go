@@ -0,0 +1,107 @@
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWindows(t *testing.T) {
+	tests := []struct {
+		name   string
+		window []float64
+		edge   float64
+	}{
+		{"Hann", Hann(9), 0},
+		{"Hamming", Hamming(9), 0.08},
+		{"Blackman", Blackman(9), 0},
+	}
+	for _, tt := range tests {
+		w := tt.window
+		if math.Abs(w[0]-tt.edge) > eps || math.Abs(w[len(w)-1]-tt.edge) > eps {
+			t.Errorf("%s edges = %v, %v; want %v", tt.name, w[0], w[len(w)-1], tt.edge)
+		}
+		if math.Abs(w[4]-1) > eps {
+			t.Errorf("%s center = %v, want 1", tt.name, w[4])
+		}
+		for i := range w {
+			if math.Abs(w[i]-w[len(w)-1-i]) > eps {
+				t.Errorf("%s is not symmetric", tt.name)
+				break
+			}
+		}
+	}
+
+	if w := Hann(1); len(w) != 1 || w[0] != 1 {
+		t.Errorf("Hann(1) = %v", w)
+	}
+	if w := Hann(0); w != nil {
+		t.Errorf("Hann(0) = %v", w)
+	}
+}
+
+func TestPeriodogram(t *testing.T) {
+	const (
+		n          = 256
+		sampleRate = 64.0
+		freq       = 8.0 // ровно 32-й отсчет спектра
+	)
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * freq * float64(i) / sampleRate)
+	}
+
+	freqs, psd, err := Periodogram(x, sampleRate, nil)
+	if err != nil {
+		t.Fatalf("Periodogram() error = %v", err)
+	}
+	peak := 0
+	for k := range psd {
+		if psd[k] > psd[peak] {
+			peak = k
+		}
+	}
+	if freqs[peak] != freq {
+		t.Errorf("peak at %v Hz, want %v", freqs[peak], freq)
+	}
+
+	// Теорема Парсеваля: интеграл СПМ равен средней мощности сигнала (0.5 для синуса)
+	total := 0.0
+	for _, p := range psd {
+		total += p * sampleRate / n
+	}
+	if math.Abs(total-0.5) > 1e-9 {
+		t.Errorf("total power = %v, want 0.5", total)
+	}
+
+	if _, _, err := Periodogram(x, sampleRate, Hann(10)); err == nil {
+		t.Errorf("expected error for window length mismatch")
+	}
+}
+
+func TestWelch(t *testing.T) {
+	x := make([]float64, 1024)
+	for i := range x {
+		x[i] = math.Cos(2 * math.Pi * 100 * float64(i) / 1000)
+	}
+
+	freqs, psd, err := Welch(x, 1000, 256, 128)
+	if err != nil {
+		t.Fatalf("Welch() error = %v", err)
+	}
+	if len(freqs) != 129 || len(psd) != 129 {
+		t.Fatalf("unexpected lengths %d, %d", len(freqs), len(psd))
+	}
+	peak := 0
+	for k := range psd {
+		if psd[k] > psd[peak] {
+			peak = k
+		}
+	}
+	if math.Abs(freqs[peak]-100) > 1000.0/256 {
+		t.Errorf("peak at %v Hz, want about 100", freqs[peak])
+	}
+
+	if _, _, err := Welch(x, 1000, 256, 256); err == nil {
+		t.Errorf("expected error for overlap >= segment")
+	}
+}
@@ -0,0 +1,469 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+)
+
+// LU - разложение P A = L U с частичным выбором ведущего элемента:
+// L нижнетреугольная с единицами на диагонали, U верхнетреугольная
+type LU struct {
+	lu    [][]float64 // L под диагональю, U на диагонали и выше
+	pivot []int       // строка i матрицы P A - строка pivot[i] матрицы A
+	sign  float64     // знак перестановки
+	norm  float64     // 1-норма исходной матрицы для оценки обусловленности
+}
+
+// DecomposeLU строит LU-разложение квадратной матрицы.
+// Разложение существует и для вырожденной матрицы; вырожденность обнаруживают Solve и Inverse
+func DecomposeLU(m *Matrix[float64]) (*LU, error) {
+	if m.rows != m.cols {
+		return nil, ErrNotSquare
+	}
+
+	n := m.rows
+	a := rowsOf(m)
+	lu := &LU{lu: a, pivot: make([]int, n), sign: 1, norm: norm1(a)}
+	for i := range lu.pivot {
+		lu.pivot[i] = i
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for i := col + 1; i < n; i++ {
+			if math.Abs(a[i][col]) > math.Abs(a[pivot][col]) {
+				pivot = i
+			}
+		}
+		if pivot != col {
+			a[pivot], a[col] = a[col], a[pivot]
+			lu.pivot[pivot], lu.pivot[col] = lu.pivot[col], lu.pivot[pivot]
+			lu.sign = -lu.sign
+		}
+		if a[col][col] == 0 {
+			continue
+		}
+
+		for i := col + 1; i < n; i++ {
+			a[i][col] /= a[col][col]
+			factor := a[i][col]
+			for j := col + 1; j < n; j++ {
+				a[i][j] -= factor * a[col][j]
+			}
+		}
+	}
+	return lu, nil
+}
+
+// L возвращает нижнетреугольный множитель с единичной диагональю
+func (d *LU) L() *Matrix[float64] {
+	n := len(d.lu)
+	l := newRows(n, n)
+	for i := 0; i < n; i++ {
+		copy(l[i], d.lu[i][:i])
+		l[i][i] = 1
+	}
+	return fromRows(l)
+}
+
+// U возвращает верхнетреугольный множитель
+func (d *LU) U() *Matrix[float64] {
+	n := len(d.lu)
+	u := newRows(n, n)
+	for i := 0; i < n; i++ {
+		copy(u[i][i:], d.lu[i][i:])
+	}
+	return fromRows(u)
+}
+
+// P возвращает матрицу перестановки, для которой P A = L U
+func (d *LU) P() *Matrix[float64] {
+	n := len(d.lu)
+	p := newRows(n, n)
+	for i, row := range d.pivot {
+		p[i][row] = 1
+	}
+	return fromRows(p)
+}
+
+// Pivot возвращает перестановку строк: строка i матрицы P A - строка Pivot()[i] матрицы A
+func (d *LU) Pivot() []int {
+	return append([]int(nil), d.pivot...)
+}
+
+// Det возвращает определитель исходной матрицы
+func (d *LU) Det() float64 {
+	det := d.sign
+	for i := range d.lu {
+		det *= d.lu[i][i]
+	}
+	return det
+}
+
+// IsSingular сообщает, есть ли на диагонали U нулевой элемент
+func (d *LU) IsSingular() bool {
+	for i := range d.lu {
+		if d.lu[i][i] == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Cond возвращает оценку числа обусловленности ||A||_1 ||A^-1||_1
+// (метод Хейгера, без вычисления обратной матрицы). Для вырожденной матрицы - +Inf
+func (d *LU) Cond() float64 {
+	if d.IsSingular() {
+		return math.Inf(1)
+	}
+	if d.norm == 0 {
+		return 0
+	}
+	return d.norm * d.inverseNorm1()
+}
+
+// Solve решает A x = b
+func (d *LU) Solve(b []float64) ([]float64, error) {
+	if len(b) != len(d.lu) {
+		return nil, ErrDimensionMismatch
+	}
+	if err := d.check(); err != nil {
+		return nil, err
+	}
+	return d.solve(b), nil
+}
+
+// Inverse возвращает обратную матрицу, решая системы для столбцов единичной матрицы
+func (d *LU) Inverse() (*Matrix[float64], error) {
+	if err := d.check(); err != nil {
+		return nil, err
+	}
+
+	n := len(d.lu)
+	inverse := newRows(n, n)
+	e := make([]float64, n)
+	for j := 0; j < n; j++ {
+		clear(e)
+		e[j] = 1
+		for i, v := range d.solve(e) {
+			inverse[i][j] = v
+		}
+	}
+	return fromRows(inverse), nil
+}
+
+// check возвращает ErrSingular или *ConditionError, если решение не имеет смысла
+func (d *LU) check() error {
+	if d.IsSingular() {
+		return ErrSingular
+	}
+	if cond := d.Cond(); cond*epsilon >= 1 {
+		return &ConditionError{Condition: cond}
+	}
+	return nil
+}
+
+// solve решает A x = b прямой и обратной подстановкой
+func (d *LU) solve(b []float64) []float64 {
+	n := len(d.lu)
+	x := make([]float64, n)
+	for i, row := range d.pivot {
+		x[i] = b[row]
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] -= d.lu[i][j] * x[j]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= d.lu[i][j] * x[j]
+		}
+		x[i] /= d.lu[i][i]
+	}
+	return x
+}
+
+// solveTransposed решает A^T x = b: U^T w = b, L^T v = w, x = P^T v
+func (d *LU) solveTransposed(b []float64) []float64 {
+	n := len(d.lu)
+	v := append([]float64(nil), b...)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			v[i] -= d.lu[j][i] * v[j]
+		}
+		v[i] /= d.lu[i][i]
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			v[i] -= d.lu[j][i] * v[j]
+		}
+	}
+	x := make([]float64, n)
+	for i, row := range d.pivot {
+		x[row] = v[i]
+	}
+	return x
+}
+
+// inverseNorm1 оценивает ||A^-1||_1 алгоритмом Хейгера
+func (d *LU) inverseNorm1() float64 {
+	n := len(d.lu)
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+
+	estimate := 0.0
+	for iter := 0; iter < 5; iter++ {
+		y := d.solve(x)
+		estimate = 0
+		sign := make([]float64, n)
+		for i, v := range y {
+			estimate += math.Abs(v)
+			sign[i] = 1
+			if v < 0 {
+				sign[i] = -1
+			}
+		}
+
+		z := d.solveTransposed(sign)
+		best, dot := 0, 0.0
+		for i, v := range z {
+			dot += v * x[i]
+			if math.Abs(v) > math.Abs(z[best]) {
+				best = i
+			}
+		}
+		if math.Abs(z[best]) <= dot {
+			break
+		}
+		clear(x)
+		x[best] = 1
+	}
+	return estimate
+}
+
+// QR - разложение A = Q R методом отражений Хаусхолдера для rows >= cols.
+// Q имеет ортонормированные столбцы (rows x cols), R - верхнетреугольная cols x cols
+type QR struct {
+	qr    [][]float64 // векторы отражений под диагональю, R над диагональю
+	rdiag []float64   // диагональ R
+}
+
+// DecomposeQR строит QR-разложение матрицы с rows >= cols
+func DecomposeQR(m *Matrix[float64]) (*QR, error) {
+	if m.rows < m.cols {
+		return nil, errors.New("QR-разложение требует, чтобы строк было не меньше, чем столбцов")
+	}
+
+	rows, cols := m.rows, m.cols
+	a := rowsOf(m)
+	rdiag := make([]float64, cols)
+	for k := 0; k < cols; k++ {
+		norm := 0.0
+		for i := k; i < rows; i++ {
+			norm = math.Hypot(norm, a[i][k])
+		}
+		if norm != 0 {
+			// Знак выбирается так, чтобы избежать вычитания близких чисел
+			if a[k][k] < 0 {
+				norm = -norm
+			}
+			for i := k; i < rows; i++ {
+				a[i][k] /= norm
+			}
+			a[k][k]++
+
+			for j := k + 1; j < cols; j++ {
+				s := 0.0
+				for i := k; i < rows; i++ {
+					s += a[i][k] * a[i][j]
+				}
+				s = -s / a[k][k]
+				for i := k; i < rows; i++ {
+					a[i][j] += s * a[i][k]
+				}
+			}
+		}
+		rdiag[k] = -norm
+	}
+	return &QR{qr: a, rdiag: rdiag}, nil
+}
+
+// Q возвращает матрицу с ортонормированными столбцами размера rows x cols
+func (d *QR) Q() *Matrix[float64] {
+	rows, cols := len(d.qr), len(d.rdiag)
+	q := newRows(rows, cols)
+	for k := cols - 1; k >= 0; k-- {
+		q[k][k] = 1
+		for j := k; j < cols; j++ {
+			if d.qr[k][k] == 0 {
+				continue
+			}
+			s := 0.0
+			for i := k; i < rows; i++ {
+				s += d.qr[i][k] * q[i][j]
+			}
+			s = -s / d.qr[k][k]
+			for i := k; i < rows; i++ {
+				q[i][j] += s * d.qr[i][k]
+			}
+		}
+	}
+	return fromRows(q)
+}
+
+// R возвращает верхнетреугольный множитель cols x cols
+func (d *QR) R() *Matrix[float64] {
+	cols := len(d.rdiag)
+	r := newRows(cols, cols)
+	for i := 0; i < cols; i++ {
+		r[i][i] = d.rdiag[i]
+		copy(r[i][i+1:], d.qr[i][i+1:cols])
+	}
+	return fromRows(r)
+}
+
+// IsFullRank сообщает, имеет ли матрица полный столбцовый ранг
+func (d *QR) IsFullRank() bool {
+	tolerance := float64(len(d.qr)) * epsilon * maxAbsSlice(d.rdiag)
+	for _, v := range d.rdiag {
+		if math.Abs(v) <= tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+// Solve находит решение задачи наименьших квадратов min ||A x - b||
+func (d *QR) Solve(b []float64) ([]float64, error) {
+	rows, cols := len(d.qr), len(d.rdiag)
+	if len(b) != rows {
+		return nil, ErrDimensionMismatch
+	}
+	if !d.IsFullRank() {
+		return nil, ErrRankDeficient
+	}
+
+	// Вычисляем Q^T b, применяя отражения по очереди
+	y := append([]float64(nil), b...)
+	for k := 0; k < cols; k++ {
+		s := 0.0
+		for i := k; i < rows; i++ {
+			s += d.qr[i][k] * y[i]
+		}
+		s = -s / d.qr[k][k]
+		for i := k; i < rows; i++ {
+			y[i] += s * d.qr[i][k]
+		}
+	}
+
+	// Обратная подстановка R x = (Q^T b)[:cols]
+	x := y[:cols]
+	for i := cols - 1; i >= 0; i-- {
+		for j := i + 1; j < cols; j++ {
+			x[i] -= d.qr[i][j] * x[j]
+		}
+		x[i] /= d.rdiag[i]
+	}
+	return x, nil
+}
+
+// Cholesky - разложение A = L L^T симметричной положительно определенной матрицы
+type Cholesky struct {
+	l [][]float64
+}
+
+// DecomposeCholesky строит разложение Холецкого. Для несимметричной матрицы
+// возвращается ErrNotSymmetric, для не положительно определенной - ErrNotPositiveDefinite
+func DecomposeCholesky(m *Matrix[float64]) (*Cholesky, error) {
+	if m.rows != m.cols {
+		return nil, ErrNotSquare
+	}
+	a := rowsOf(m)
+	if !isSymmetric(a) {
+		return nil, ErrNotSymmetric
+	}
+
+	n := m.rows
+	l := newRows(n, n)
+	for j := 0; j < n; j++ {
+		diagonal := a[j][j]
+		for k := 0; k < j; k++ {
+			diagonal -= l[j][k] * l[j][k]
+		}
+		if diagonal <= 0 {
+			return nil, ErrNotPositiveDefinite
+		}
+		l[j][j] = math.Sqrt(diagonal)
+
+		for i := j + 1; i < n; i++ {
+			s := a[i][j]
+			for k := 0; k < j; k++ {
+				s -= l[i][k] * l[j][k]
+			}
+			l[i][j] = s / l[j][j]
+		}
+	}
+	return &Cholesky{l: l}, nil
+}
+
+// L возвращает нижнетреугольный множитель
+func (d *Cholesky) L() *Matrix[float64] {
+	return fromRows(d.l)
+}
+
+// Det возвращает определитель исходной матрицы
+func (d *Cholesky) Det() float64 {
+	det := 1.0
+	for i := range d.l {
+		det *= d.l[i][i] * d.l[i][i]
+	}
+	return det
+}
+
+// Solve решает A x = b двумя треугольными подстановками
+func (d *Cholesky) Solve(b []float64) ([]float64, error) {
+	n := len(d.l)
+	if len(b) != n {
+		return nil, ErrDimensionMismatch
+	}
+
+	x := append([]float64(nil), b...)
+	for i := 0; i < n; i++ {
+		for k := 0; k < i; k++ {
+			x[i] -= d.l[i][k] * x[k]
+		}
+		x[i] /= d.l[i][i]
+	}
+	for i := n - 1; i >= 0; i-- {
+		for k := i + 1; k < n; k++ {
+			x[i] -= d.l[k][i] * x[k]
+		}
+		x[i] /= d.l[i][i]
+	}
+	return x, nil
+}
+
+// norm1 возвращает 1-норму (наибольшую сумму модулей по столбцам)
+func norm1(a [][]float64) float64 {
+	result := 0.0
+	for j := range a[0] {
+		sum := 0.0
+		for i := range a {
+			sum += math.Abs(a[i][j])
+		}
+		result = max(result, sum)
+	}
+	return result
+}
+
+// maxAbsSlice возвращает наибольший модуль элемента среза
+func maxAbsSlice(values []float64) float64 {
+	result := 0.0
+	for _, v := range values {
+		result = max(result, math.Abs(v))
+	}
+	return result
+}
@@ -0,0 +1,119 @@
+package matrix
+
+import (
+	"math"
+	"sort"
+)
+
+// Eigen - спектральное разложение симметричной матрицы A = V diag(Values) V^T
+type Eigen struct {
+	Values  []float64        // собственные значения по возрастанию
+	Vectors *Matrix[float64] // столбец i - нормированный собственный вектор для Values[i]
+}
+
+// maxJacobiSweeps ограничивает число проходов метода Якоби; на практике хватает 10-15
+const maxJacobiSweeps = 100
+
+// EigenSymmetric находит собственные значения и векторы симметричной матрицы
+// циклическим методом вращений Якоби. Метод медленнее QR-алгоритма,
+// но дает ортогональные векторы с высокой точностью
+func EigenSymmetric(m *Matrix[float64]) (*Eigen, error) {
+	if m.rows != m.cols {
+		return nil, ErrNotSquare
+	}
+	a := rowsOf(m)
+	if !isSymmetric(a) {
+		return nil, ErrNotSymmetric
+	}
+
+	n := m.rows
+	v := newRows(n, n)
+	for i := range v {
+		v[i][i] = 1
+	}
+
+	converged := false
+	for sweep := 0; sweep < maxJacobiSweeps && !converged; sweep++ {
+		off := 0.0
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				off += a[i][j] * a[i][j]
+			}
+		}
+		if off <= epsilon*epsilon*frobenius2(a) {
+			converged = true
+			break
+		}
+
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				if a[p][q] != 0 {
+					rotate(a, v, p, q)
+				}
+			}
+		}
+	}
+	if !converged {
+		return nil, ErrNoConvergence
+	}
+
+	// Сортируем пары по возрастанию собственных значений
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return a[order[i]][order[i]] < a[order[j]][order[j]]
+	})
+
+	values := make([]float64, n)
+	vectors := newRows(n, n)
+	for k, idx := range order {
+		values[k] = a[idx][idx]
+		for i := 0; i < n; i++ {
+			vectors[i][k] = v[i][idx]
+		}
+	}
+	return &Eigen{Values: values, Vectors: fromRows(vectors)}, nil
+}
+
+// rotate обнуляет элемент a[p][q] вращением Якоби и накапливает вращение в v
+func rotate(a, v [][]float64, p, q int) {
+	theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+	// Меньший по модулю корень t^2 + 2 theta t - 1 = 0 для устойчивости
+	t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+	if theta < 0 {
+		t = -t
+	}
+	c := 1 / math.Sqrt(t*t+1)
+	s := t * c
+
+	for k := range a {
+		akp, akq := a[k][p], a[k][q]
+		a[k][p] = c*akp - s*akq
+		a[k][q] = s*akp + c*akq
+	}
+	for k := range a {
+		apk, aqk := a[p][k], a[q][k]
+		a[p][k] = c*apk - s*aqk
+		a[q][k] = s*apk + c*aqk
+	}
+	a[p][q], a[q][p] = 0, 0
+
+	for k := range v {
+		vkp, vkq := v[k][p], v[k][q]
+		v[k][p] = c*vkp - s*vkq
+		v[k][q] = s*vkp + c*vkq
+	}
+}
+
+// frobenius2 возвращает квадрат нормы Фробениуса
+func frobenius2(a [][]float64) float64 {
+	sum := 0.0
+	for _, row := range a {
+		for _, x := range row {
+			sum += x * x
+		}
+	}
+	return sum
+}
@@ -0,0 +1,333 @@
+package matrix
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+)
+
+// ErrBinaryFormat возвращается ReadBinary, если данные не являются матрицей
+// в двоичном формате пакета или тип элементов не совпадает
+var ErrBinaryFormat = errors.New("неверный двоичный формат матрицы")
+
+// maxReadElements - наибольшее число элементов плотной матрицы при чтении
+// Matrix Market и двоичного формата. Размеры берутся из заголовка файла, и без
+// предела поврежденный файл запросил бы память до того, как прочитаны данные
+const maxReadElements = 1 << 27
+
+// CSVOptions настраивает чтение и запись CSV
+type CSVOptions struct {
+	Delimiter rune // разделитель полей, по умолчанию ','
+}
+
+// csvOptionsOf возвращает опции со значениями по умолчанию
+func csvOptionsOf(opts []CSVOptions) CSVOptions {
+	var o CSVOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	if o.Delimiter == 0 {
+		o.Delimiter = ','
+	}
+	return o
+}
+
+// ReadCSV читает матрицу из CSV: одна запись - одна строка матрицы.
+// Все записи должны иметь одинаковое число полей; пробелы перед значением игнорируются.
+// Ошибки разбора содержат строку и столбец элемента (с единицы)
+func ReadCSV[T Scalar](in io.Reader, opts ...CSVOptions) (*Matrix[T], error) {
+	o := csvOptionsOf(opts)
+	reader := csv.NewReader(in)
+	reader.Comma = o.Delimiter
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	var data []T
+	cols := 0
+	for i := 1; ; i++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("csv: %w", err)
+		}
+		if i == 1 {
+			cols = len(record)
+		} else if len(record) != cols {
+			return nil, fmt.Errorf("csv, строка %d: ожидалось %d полей, получено %d", i, cols, len(record))
+		}
+		for j, field := range record {
+			v, err := parseElement[T](field)
+			if err != nil {
+				return nil, fmt.Errorf("csv, строка %d, столбец %d: %v", i, j+1, err)
+			}
+			data = append(data, v)
+		}
+	}
+	if len(data) == 0 {
+		return nil, errors.New("csv: значения не могут быть пустыми")
+	}
+	return NewFromData(len(data)/cols, cols, data)
+}
+
+// WriteCSV записывает матрицу в CSV, по записи на строку
+func WriteCSV[T Scalar](w io.Writer, m *Matrix[T], opts ...CSVOptions) error {
+	o := csvOptionsOf(opts)
+	writer := csv.NewWriter(w)
+	writer.Comma = o.Delimiter
+
+	record := make([]string, m.cols)
+	for i := 0; i < m.rows; i++ {
+		for j, v := range m.row(i) {
+			record[j] = formatScalar(v)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// MtxFormat - формат Matrix Market при записи плотной матрицы
+type MtxFormat string
+
+const (
+	MtxArray      MtxFormat = "array"      // все элементы по столбцам
+	MtxCoordinate MtxFormat = "coordinate" // только ненулевые элементы
+)
+
+// MtxOptions настраивает запись Matrix Market
+type MtxOptions struct {
+	Format MtxFormat // по умолчанию MtxArray
+}
+
+// ReadMatrixMarketDense читает плотную матрицу в формате Matrix Market.
+// Поддерживает то же, что ReadMatrixMarket; повторы формата coordinate складываются
+func ReadMatrixMarketDense[T Scalar](in io.Reader) (*Matrix[T], error) {
+	r, h, rows, cols, entries, err := readMtxHeader(in)
+	if err != nil {
+		return nil, err
+	}
+	if rows*cols > maxReadElements {
+		return nil, r.errorf("матрица %d x %d больше %d элементов", rows, cols, maxReadElements)
+	}
+	m, err := New[T](rows, cols)
+	if err != nil {
+		return nil, r.errorf("%v", err)
+	}
+	err = readMtxEntries(r, h, rows, cols, entries, func(i, j int, v T) {
+		m.data[i*m.stride+j] += v
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WriteMatrixMarketDense записывает плотную матрицу в формате Matrix Market:
+// array general по умолчанию или coordinate general с ненулевыми элементами
+func WriteMatrixMarketDense[T Scalar](w io.Writer, m *Matrix[T], opts ...MtxOptions) error {
+	var o MtxOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	switch o.Format {
+	case "", MtxArray:
+	case MtxCoordinate:
+		return WriteMatrixMarket[T](w, DenseToCOO(m))
+	default:
+		return fmt.Errorf("matrix market: неподдерживаемый формат %q", o.Format)
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%%%%MatrixMarket matrix array %s general\n", mtxField[T]())
+	fmt.Fprintf(bw, "%d %d\n", m.rows, m.cols)
+	for j := 0; j < m.cols; j++ {
+		for i := 0; i < m.rows; i++ {
+			fmt.Fprintln(bw, formatScalar(m.at(i, j)))
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadJSON читает матрицу из JSON-массива строк: [[1, 2], [3, 4]].
+// Элементы могут быть числами или строками и разбираются через cast
+func ReadJSON[T Scalar](in io.Reader) (*Matrix[T], error) {
+	decoder := json.NewDecoder(in)
+	decoder.UseNumber()
+	var values [][]any
+	if err := decoder.Decode(&values); err != nil {
+		return nil, fmt.Errorf("json: %w", err)
+	}
+	if len(values) == 0 || len(values[0]) == 0 {
+		return nil, errors.New("json: значения не могут быть пустыми")
+	}
+
+	m, _ := New[T](len(values), len(values[0]))
+	for i, row := range values {
+		if len(row) != m.cols {
+			return nil, fmt.Errorf("json, строка %d: ожидалось %d элементов, получено %d", i+1, m.cols, len(row))
+		}
+		for j, value := range row {
+			if number, ok := value.(json.Number); ok {
+				value = string(number)
+			}
+			v, err := parseElement[T](value)
+			if err != nil {
+				return nil, fmt.Errorf("json, строка %d, столбец %d: %v", i+1, j+1, err)
+			}
+			m.set(i, j, v)
+		}
+	}
+	return m, nil
+}
+
+// WriteJSON записывает матрицу как JSON-массив строк
+func WriteJSON[T Scalar](w io.Writer, m *Matrix[T]) error {
+	values := make([][]T, m.rows)
+	for i := range values {
+		values[i] = m.row(i)
+	}
+	return json.NewEncoder(w).Encode(values)
+}
+
+// Двоичный формат: заголовок из 16 байт и элементы по строкам в little-endian.
+//
+//	0  magic "TMTX"
+//	4  версия формата (1)
+//	5  тип элементов: binaryInt32, binaryInt64, binaryFloat32, binaryFloat64
+//	6  резерв (2 байта, нули)
+//	8  число строк, uint32
+//	12 число столбцов, uint32
+//
+// int записывается как int64, чтобы файл не зависел от разрядности платформы
+const (
+	binaryMagic   = "TMTX"
+	binaryVersion = 1
+	binaryHeader  = 16
+	binaryChunk   = 4096 // элементов в одной порции чтения
+)
+
+const (
+	binaryInt32 byte = iota + 1
+	binaryInt64
+	binaryFloat32
+	binaryFloat64
+)
+
+// binaryKind возвращает код типа элементов и размер элемента в байтах
+func binaryKind[T Scalar]() (byte, int) {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int32:
+		return binaryInt32, 4
+	case reflect.Float32:
+		return binaryFloat32, 4
+	case reflect.Float64:
+		return binaryFloat64, 8
+	default: // int, int64
+		return binaryInt64, 8
+	}
+}
+
+// WriteBinary записывает матрицу в компактном двоичном формате
+func WriteBinary[T Scalar](w io.Writer, m *Matrix[T]) error {
+	if uint64(m.rows) > math.MaxUint32 || uint64(m.cols) > math.MaxUint32 {
+		return errors.New("размеры матрицы не помещаются в двоичный формат")
+	}
+	kind, size := binaryKind[T]()
+
+	header := make([]byte, binaryHeader, binaryHeader+m.cols*size)
+	copy(header, binaryMagic)
+	header[4] = binaryVersion
+	header[5] = kind
+	binary.LittleEndian.PutUint32(header[8:], uint32(m.rows))
+	binary.LittleEndian.PutUint32(header[12:], uint32(m.cols))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	buf := header[:0]
+	for i := 0; i < m.rows; i++ {
+		buf = buf[:0]
+		for _, v := range m.row(i) {
+			switch kind {
+			case binaryInt32:
+				buf = binary.LittleEndian.AppendUint32(buf, uint32(int32(v)))
+			case binaryInt64:
+				buf = binary.LittleEndian.AppendUint64(buf, uint64(int64(v)))
+			case binaryFloat32:
+				buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(v)))
+			case binaryFloat64:
+				buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(float64(v)))
+			}
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadBinary читает матрицу, записанную WriteBinary. Тип элементов в файле
+// должен совпадать с T (int и int64 взаимозаменяемы), иначе возвращается ErrBinaryFormat
+func ReadBinary[T Scalar](in io.Reader) (*Matrix[T], error) {
+	header := make([]byte, binaryHeader)
+	if _, err := io.ReadFull(in, header); err != nil {
+		return nil, fmt.Errorf("%w: неполный заголовок", ErrBinaryFormat)
+	}
+	if string(header[:4]) != binaryMagic {
+		return nil, fmt.Errorf("%w: неизвестная сигнатура %q", ErrBinaryFormat, header[:4])
+	}
+	if header[4] != binaryVersion {
+		return nil, fmt.Errorf("%w: неподдерживаемая версия %d", ErrBinaryFormat, header[4])
+	}
+	kind, size := binaryKind[T]()
+	if header[5] != kind {
+		return nil, fmt.Errorf("%w: тип элементов %d не совпадает с ожидаемым %d", ErrBinaryFormat, header[5], kind)
+	}
+	rows := int(binary.LittleEndian.Uint32(header[8:]))
+	cols := int(binary.LittleEndian.Uint32(header[12:]))
+	if rows == 0 || cols == 0 {
+		return nil, fmt.Errorf("%w: нулевой размер матрицы", ErrBinaryFormat)
+	}
+
+	if cols > maxReadElements/rows {
+		return nil, fmt.Errorf("%w: матрица %d x %d больше %d элементов", ErrBinaryFormat, rows, cols, maxReadElements)
+	}
+
+	// Элементы читаются порциями и добавляются по мере чтения: размеры из заголовка
+	// не должны заставлять выделять память под данные, которых в файле нет
+	total := rows * cols
+	var data []T
+	buf := make([]byte, min(total, binaryChunk)*size)
+	for len(data) < total {
+		chunk := buf[:min(total-len(data), binaryChunk)*size]
+		n, err := io.ReadFull(in, chunk)
+		for b := chunk[:n-n%size]; len(b) > 0; b = b[size:] {
+			switch kind {
+			case binaryInt32:
+				data = append(data, T(int32(binary.LittleEndian.Uint32(b))))
+			case binaryInt64:
+				data = append(data, T(int64(binary.LittleEndian.Uint64(b))))
+			case binaryFloat32:
+				data = append(data, T(math.Float32frombits(binary.LittleEndian.Uint32(b))))
+			case binaryFloat64:
+				data = append(data, T(math.Float64frombits(binary.LittleEndian.Uint64(b))))
+			}
+		}
+		if err != nil {
+			k := len(data)
+			return nil, fmt.Errorf("%w: данные обрываются на элементе (%d, %d)", ErrBinaryFormat, k/cols+1, k%cols+1)
+		}
+	}
+	return NewFromData(rows, cols, data)
+}
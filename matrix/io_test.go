@@ -0,0 +1,256 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	m, _ := NewWithValues([][]float64{{1.5, -2}, {0.001, 3}})
+	for _, delimiter := range []rune{',', ';', '\t'} {
+		var buf bytes.Buffer
+		if err := WriteCSV(&buf, m, CSVOptions{Delimiter: delimiter}); err != nil {
+			t.Fatalf("WriteCSV() error = %v", err)
+		}
+		got, err := ReadCSV[float64](&buf, CSVOptions{Delimiter: delimiter})
+		if err != nil {
+			t.Fatalf("ReadCSV(%q) error = %v", delimiter, err)
+		}
+		if !got.IsEqual(m) {
+			t.Errorf("ReadCSV(%q) = %v", delimiter, got.data)
+		}
+	}
+
+	var buf bytes.Buffer
+	view, _ := m.Col(1)
+	_ = WriteCSV(&buf, view)
+	if buf.String() != "-2\n3\n" {
+		t.Errorf("WriteCSV(view) = %q", buf.String())
+	}
+}
+
+func TestNonFiniteRoundTrip(t *testing.T) {
+	m, _ := NewWithValues([][]float64{{math.NaN(), math.Inf(1)}, {math.Inf(-1), 1.5}})
+	same := func(got *Matrix[float64]) bool {
+		return math.IsNaN(got.at(0, 0)) && got.at(0, 1) == math.Inf(1) && got.at(1, 0) == math.Inf(-1) && got.at(1, 1) == 1.5
+	}
+
+	var buf bytes.Buffer
+	_ = WriteCSV(&buf, m)
+	if got, err := ReadCSV[float64](&buf); err != nil || !same(got) {
+		t.Errorf("ReadCSV() = %v, %v", got, err)
+	}
+
+	for _, format := range []MtxFormat{MtxArray, MtxCoordinate} {
+		buf.Reset()
+		_ = WriteMatrixMarketDense(&buf, m, MtxOptions{Format: format})
+		if got, err := ReadMatrixMarketDense[float64](&buf); err != nil || !same(got) {
+			t.Errorf("ReadMatrixMarketDense(%s) = %v, %v", format, got, err)
+		}
+	}
+
+	// Для целых типов эти значения по-прежнему ошибка
+	if _, err := ReadCSV[int](strings.NewReader("NaN\n")); err == nil {
+		t.Errorf("ReadCSV[int](NaN) expected error")
+	}
+}
+
+func TestReadCSVErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"empty", "", "пустыми"},
+		{"ragged", "1,2\n3\n", "строка 2"},
+		{"bad element", "1, 2\n3, abc\n", "строка 2, столбец 2"},
+		{"fraction into int", "1,2.5\n", "строка 1, столбец 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadCSV[int](strings.NewReader(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ReadCSV() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatrixMarketDense(t *testing.T) {
+	m, _ := NewWithValues([][]int{{1, 0}, {0, 4}, {5, 6}})
+
+	var array bytes.Buffer
+	if err := WriteMatrixMarketDense(&array, m); err != nil {
+		t.Fatalf("WriteMatrixMarketDense() error = %v", err)
+	}
+	want := "%%MatrixMarket matrix array integer general\n3 2\n1\n0\n5\n0\n4\n6\n"
+	if array.String() != want {
+		t.Errorf("WriteMatrixMarketDense() = %q, want %q", array.String(), want)
+	}
+
+	var coordinate bytes.Buffer
+	_ = WriteMatrixMarketDense(&coordinate, m, MtxOptions{Format: MtxCoordinate})
+	if !strings.HasPrefix(coordinate.String(), "%%MatrixMarket matrix coordinate integer general\n3 2 4\n") {
+		t.Errorf("coordinate = %q", coordinate.String())
+	}
+
+	for _, buf := range []*bytes.Buffer{&array, &coordinate} {
+		got, err := ReadMatrixMarketDense[int](buf)
+		if err != nil {
+			t.Fatalf("ReadMatrixMarketDense() error = %v", err)
+		}
+		if !got.IsEqual(m) {
+			t.Errorf("ReadMatrixMarketDense() = %v", got.data)
+		}
+	}
+
+	symmetric := "%%MatrixMarket matrix array real symmetric\n2 2\n1\n2\n3\n"
+	got, err := ReadMatrixMarketDense[float64](strings.NewReader(symmetric))
+	if err != nil {
+		t.Fatalf("ReadMatrixMarketDense(symmetric) error = %v", err)
+	}
+	if want, _ := NewWithValues([][]float64{{1, 2}, {2, 3}}); !got.IsEqual(want) {
+		t.Errorf("ReadMatrixMarketDense(symmetric) = %v", got.data)
+	}
+}
+
+func TestReadMatrixMarketDenseSize(t *testing.T) {
+	tests := []struct {
+		name, sizes, want string
+	}{
+		{"overflow", "4294967296 4294967296 1", "строка 2: размеры"},
+		{"product overflow", "3037000500 3037000500 1", "строка 2: размеры"},
+		{"too large", "65536 65536 1", "строка 2: матрица 65536 x 65536"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := "%%MatrixMarket matrix coordinate real general\n" + tt.sizes + "\n1 1 1\n"
+			_, err := ReadMatrixMarketDense[float64](strings.NewReader(input))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ReadMatrixMarketDense() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	// Разреженное чтение не выделяет rows*cols, поэтому большие размеры допустимы
+	input := "%%MatrixMarket matrix coordinate real general\n65536 65536 1\n1 1 1\n"
+	if coo, err := ReadMatrixMarket[float64](strings.NewReader(input)); err != nil || coo.NNZ() != 1 {
+		t.Errorf("ReadMatrixMarket() = %v, %v", coo, err)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	m, _ := NewWithValues([][]float64{{1, 2.5}, {-3, 0}})
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, m); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	if buf.String() != "[[1,2.5],[-3,0]]\n" {
+		t.Errorf("WriteJSON() = %q", buf.String())
+	}
+	got, err := ReadJSON[float64](&buf)
+	if err != nil {
+		t.Fatalf("ReadJSON() error = %v", err)
+	}
+	if !got.IsEqual(m) {
+		t.Errorf("ReadJSON() = %v", got.data)
+	}
+
+	// Строковые элементы тоже разбираются через cast
+	got, err = ReadJSON[float64](strings.NewReader(`[["1.5", 2]]`))
+	if err != nil || got.at(0, 0) != 1.5 || got.at(0, 1) != 2 {
+		t.Errorf("ReadJSON(strings) = %v, %v", got, err)
+	}
+
+	errorTests := []struct {
+		input string
+		want  string
+	}{
+		{`[]`, "пустыми"},
+		{`[[1, 2], [3]]`, "строка 2"},
+		{`[[1, 2], [3, "x"]]`, "строка 2, столбец 2"},
+		{`{"a": 1}`, "json"},
+	}
+	for _, tt := range errorTests {
+		if _, err := ReadJSON[float64](strings.NewReader(tt.input)); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ReadJSON(%s) error = %v, want %q", tt.input, err, tt.want)
+		}
+	}
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	floats, _ := NewWithValues([][]float64{{1.5, -2}, {3, 1e300}})
+	var buf bytes.Buffer
+	if err := WriteBinary(&buf, floats); err != nil {
+		t.Fatalf("WriteBinary() error = %v", err)
+	}
+	if buf.Len() != binaryHeader+4*8 {
+		t.Errorf("size = %d", buf.Len())
+	}
+	got, err := ReadBinary[float64](bytes.NewReader(buf.Bytes()))
+	if err != nil || !got.IsEqual(floats) {
+		t.Fatalf("ReadBinary() = %v, %v", got, err)
+	}
+
+	ints, _ := NewWithValues([][]int32{{-1, 2, 3}})
+	buf.Reset()
+	_ = WriteBinary(&buf, ints)
+	gotInts, err := ReadBinary[int32](&buf)
+	if err != nil || !gotInts.IsEqual(ints) {
+		t.Errorf("ReadBinary[int32]() = %v, %v", gotInts, err)
+	}
+
+	// Больше одной порции чтения
+	large, _ := New[float32](100, 50)
+	large.Apply(func(_ float32, i, j int) float32 { return float32(i*50 + j) })
+	buf.Reset()
+	_ = WriteBinary(&buf, large)
+	gotLarge, err := ReadBinary[float32](&buf)
+	if err != nil || !gotLarge.IsEqual(large) {
+		t.Errorf("ReadBinary(100x50) = %v", err)
+	}
+}
+
+// binaryHeaderOf возвращает заголовок двоичного файла с заданными размерами без данных
+func binaryHeaderOf(data []byte, rows, cols uint32) []byte {
+	header := bytes.Clone(data[:binaryHeader])
+	binary.LittleEndian.PutUint32(header[8:], rows)
+	binary.LittleEndian.PutUint32(header[12:], cols)
+	return header
+}
+
+func TestReadBinaryErrors(t *testing.T) {
+	m, _ := NewWithValues([][]float64{{1, 2}, {3, 4}})
+	var buf bytes.Buffer
+	_ = WriteBinary(&buf, m)
+	data := buf.Bytes()
+
+	tests := []struct {
+		name  string
+		input []byte
+		want  string
+	}{
+		{"short header", data[:5], "заголовок"},
+		{"magic", append([]byte("XXXX"), data[4:]...), "сигнатура"},
+		{"truncated", data[:len(data)-4], "(2, 2)"},
+		{"size overflow", binaryHeaderOf(data, 0xFFFFFFFF, 0xFFFFFFFF), "больше"},
+		{"too large", binaryHeaderOf(data, 65536, 65536), "больше"},
+		{"missing data", binaryHeaderOf(data, 1, 1<<20), "(1, 1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadBinary[float64](bytes.NewReader(tt.input))
+			if !errors.Is(err, ErrBinaryFormat) || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ReadBinary() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := ReadBinary[int](bytes.NewReader(data)); !errors.Is(err, ErrBinaryFormat) {
+		t.Errorf("ReadBinary[int](float64 data) error = %v", err)
+	}
+}
@@ -0,0 +1,156 @@
+package matrix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Ошибки линейной алгебры для матриц float64
+var (
+	ErrNotSquare           = errors.New("операция определена только для квадратной матрицы")
+	ErrDimensionMismatch   = errors.New("размеры матрицы и вектора не согласованы")
+	ErrSingular            = errors.New("матрица вырождена")
+	ErrIllConditioned      = errors.New("матрица плохо обусловлена")
+	ErrRankDeficient       = errors.New("матрица не имеет полного столбцового ранга")
+	ErrNotSymmetric        = errors.New("матрица не симметрична")
+	ErrNotPositiveDefinite = errors.New("матрица не является положительно определенной")
+	ErrNoConvergence       = errors.New("итерационный метод не сошелся")
+)
+
+// epsilon - машинная точность float64
+const epsilon = 0x1p-52
+
+// ConditionError сообщает, что число обусловленности матрицы настолько велико,
+// что решение теряет все значащие цифры. errors.Is(err, ErrIllConditioned) истинно
+type ConditionError struct {
+	Condition float64 // оценка числа обусловленности в 1-норме
+}
+
+func (e *ConditionError) Error() string {
+	return fmt.Sprintf("%s: число обусловленности %.3g", ErrIllConditioned, e.Condition)
+}
+
+func (e *ConditionError) Unwrap() error {
+	return ErrIllConditioned
+}
+
+// Det вычисляет определитель квадратной матрицы через LU-разложение
+func Det(m *Matrix[float64]) (float64, error) {
+	lu, err := DecomposeLU(m)
+	if err != nil {
+		return 0, err
+	}
+	return lu.Det(), nil
+}
+
+// Inverse возвращает обратную матрицу. Для вырожденной матрицы возвращается ErrSingular,
+// для плохо обусловленной - *ConditionError
+func Inverse(m *Matrix[float64]) (*Matrix[float64], error) {
+	lu, err := DecomposeLU(m)
+	if err != nil {
+		return nil, err
+	}
+	return lu.Inverse()
+}
+
+// Solve решает систему A x = b с квадратной матрицей A методом LU-разложения
+// с частичным выбором ведущего элемента
+func Solve(a *Matrix[float64], b []float64) ([]float64, error) {
+	lu, err := DecomposeLU(a)
+	if err != nil {
+		return nil, err
+	}
+	return lu.Solve(b)
+}
+
+// LeastSquares находит x, минимизирующий ||A x - b||, через QR-разложение.
+// Требуется rows >= cols и полный столбцовый ранг
+func LeastSquares(a *Matrix[float64], b []float64) ([]float64, error) {
+	qr, err := DecomposeQR(a)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Solve(b)
+}
+
+// Rank вычисляет численный ранг матрицы приведением к ступенчатому виду.
+// Элементы меньше max(rows, cols) * eps * max|a_ij| считаются нулевыми
+func Rank(m *Matrix[float64]) int {
+	a := rowsOf(m)
+	rows, cols := m.rows, m.cols
+
+	tolerance := float64(max(rows, cols)) * epsilon * maxAbs(a)
+	rank := 0
+	for col := 0; col < cols && rank < rows; col++ {
+		pivot := rank
+		for i := rank + 1; i < rows; i++ {
+			if math.Abs(a[i][col]) > math.Abs(a[pivot][col]) {
+				pivot = i
+			}
+		}
+		if math.Abs(a[pivot][col]) <= tolerance {
+			continue
+		}
+		a[pivot], a[rank] = a[rank], a[pivot]
+
+		for i := rank + 1; i < rows; i++ {
+			factor := a[i][col] / a[rank][col]
+			for j := col; j < cols; j++ {
+				a[i][j] -= factor * a[rank][j]
+			}
+		}
+		rank++
+	}
+	return rank
+}
+
+// rowsOf возвращает копию элементов матрицы по строкам для вычислений на месте.
+// Строки - срезы одного непрерывного массива
+func rowsOf(m *Matrix[float64]) [][]float64 {
+	clone := m.Clone()
+	rows := make([][]float64, m.rows)
+	for i := range rows {
+		rows[i] = clone.row(i)
+	}
+	return rows
+}
+
+// fromRows копирует строки в новую матрицу
+func fromRows(rows [][]float64) *Matrix[float64] {
+	m, _ := NewWithValues(rows)
+	return m
+}
+
+// newRows создает нулевой массив строк rows x cols
+func newRows(rows, cols int) [][]float64 {
+	result := make([][]float64, rows)
+	for i := range result {
+		result[i] = make([]float64, cols)
+	}
+	return result
+}
+
+// maxAbs возвращает наибольший модуль элемента
+func maxAbs(a [][]float64) float64 {
+	result := 0.0
+	for _, row := range a {
+		for _, v := range row {
+			result = max(result, math.Abs(v))
+		}
+	}
+	return result
+}
+
+// isSymmetric проверяет симметричность с относительной погрешностью
+func isSymmetric(a [][]float64) bool {
+	tolerance := 1e-12 * max(1, maxAbs(a))
+	for i := range a {
+		for j := i + 1; j < len(a); j++ {
+			if math.Abs(a[i][j]-a[j][i]) > tolerance {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,232 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+const tolerance = 1e-10
+
+func mustFloat(t *testing.T, values [][]float64) *Matrix[float64] {
+	t.Helper()
+	m, err := NewWithValues(values)
+	if err != nil {
+		t.Fatalf("NewWithValues() error = %v", err)
+	}
+	return m
+}
+
+func multiply(t *testing.T, a, b *Matrix[float64]) *Matrix[float64] {
+	t.Helper()
+	product, err := a.Multiply(b, func(x, y float64) float64 { return x * y }, func(x, y float64) float64 { return x + y })
+	if err != nil {
+		t.Fatalf("Multiply() error = %v", err)
+	}
+	return product
+}
+
+func assertMatrixClose(t *testing.T, name string, got, want *Matrix[float64]) {
+	t.Helper()
+	if got.Rows() != want.Rows() || got.Cols() != want.Cols() {
+		t.Fatalf("%s: size %dx%d, want %dx%d", name, got.Rows(), got.Cols(), want.Rows(), want.Cols())
+	}
+	for i := 0; i < got.Rows(); i++ {
+		for j := 0; j < got.Cols(); j++ {
+			g, _ := got.Get(i, j)
+			w, _ := want.Get(i, j)
+			if math.Abs(g-w) > tolerance {
+				t.Fatalf("%s[%d][%d] = %v, want %v", name, i, j, g, w)
+			}
+		}
+	}
+}
+
+func assertVectorClose(t *testing.T, name string, got, want []float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: length %d, want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > tolerance {
+			t.Fatalf("%s = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestDetAndInverse(t *testing.T) {
+	a := mustFloat(t, [][]float64{{0, 2, 1}, {1, 1, 0}, {3, 0, 4}})
+
+	det, err := Det(a)
+	if err != nil || math.Abs(det-(-11)) > tolerance {
+		t.Errorf("Det() = %v, %v; want -11", det, err)
+	}
+
+	inverse, err := Inverse(a)
+	if err != nil {
+		t.Fatalf("Inverse() error = %v", err)
+	}
+	identity, _ := Identity(Arithmetic[float64]{}, 3)
+	assertMatrixClose(t, "A * A^-1", multiply(t, a, inverse), identity)
+
+	if _, err := Det(mustFloat(t, [][]float64{{1, 2, 3}})); !errors.Is(err, ErrNotSquare) {
+		t.Errorf("Det() of 1x3 error = %v, want ErrNotSquare", err)
+	}
+}
+
+func TestSingularAndIllConditioned(t *testing.T) {
+	singular := mustFloat(t, [][]float64{{1, 2}, {2, 4}})
+	if _, err := Inverse(singular); !errors.Is(err, ErrSingular) {
+		t.Errorf("Inverse() error = %v, want ErrSingular", err)
+	}
+	if det, _ := Det(singular); det != 0 {
+		t.Errorf("Det() = %v, want 0", det)
+	}
+
+	// Матрица Гильберта 14x14 имеет число обусловленности около 1e19
+	n := 14
+	hilbert := make([][]float64, n)
+	for i := range hilbert {
+		hilbert[i] = make([]float64, n)
+		for j := range hilbert[i] {
+			hilbert[i][j] = 1 / float64(i+j+1)
+		}
+	}
+	_, err := Solve(mustFloat(t, hilbert), make([]float64, n))
+	var condErr *ConditionError
+	if !errors.As(err, &condErr) || !errors.Is(err, ErrIllConditioned) {
+		t.Fatalf("Solve() error = %v, want *ConditionError", err)
+	}
+	if condErr.Condition < 1e15 {
+		t.Errorf("condition estimate = %g, want > 1e15", condErr.Condition)
+	}
+}
+
+func TestLU(t *testing.T) {
+	a := mustFloat(t, [][]float64{{2, 1, 1}, {4, -6, 0}, {-2, 7, 2}})
+	lu, err := DecomposeLU(a)
+	if err != nil {
+		t.Fatalf("DecomposeLU() error = %v", err)
+	}
+	assertMatrixClose(t, "L U", multiply(t, lu.L(), lu.U()), multiply(t, lu.P(), a))
+
+	x, err := lu.Solve([]float64{5, -2, 9})
+	if err != nil {
+		t.Fatalf("Solve() error = %v", err)
+	}
+	assertVectorClose(t, "x", x, []float64{1, 1, 2})
+
+	if cond := lu.Cond(); cond < 1 || math.IsInf(cond, 0) {
+		t.Errorf("Cond() = %v", cond)
+	}
+	if _, err := lu.Solve([]float64{1}); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("Solve() error = %v, want ErrDimensionMismatch", err)
+	}
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		values [][]float64
+		want   int
+	}{
+		{[][]float64{{1, 2}, {3, 4}}, 2},
+		{[][]float64{{1, 2, 3}, {2, 4, 6}, {1, 0, 1}}, 2},
+		{[][]float64{{0, 0}, {0, 0}}, 0},
+		{[][]float64{{1, 2, 3, 4}}, 1},
+		{[][]float64{{1}, {2}, {3}}, 1},
+	}
+	for _, tt := range tests {
+		if got := Rank(mustFloat(t, tt.values)); got != tt.want {
+			t.Errorf("Rank(%v) = %d, want %d", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestQR(t *testing.T) {
+	a := mustFloat(t, [][]float64{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}, {1, 1, 1}})
+	qr, err := DecomposeQR(a)
+	if err != nil {
+		t.Fatalf("DecomposeQR() error = %v", err)
+	}
+	q, r := qr.Q(), qr.R()
+	assertMatrixClose(t, "Q R", multiply(t, q, r), a)
+
+	identity, _ := Identity(Arithmetic[float64]{}, 3)
+	assertMatrixClose(t, "Q^T Q", multiply(t, q.Transpose(), q), identity)
+	for i := 1; i < 3; i++ {
+		for j := 0; j < i; j++ {
+			if v, _ := r.Get(i, j); v != 0 {
+				t.Errorf("R[%d][%d] = %v, want 0", i, j, v)
+			}
+		}
+	}
+
+	if _, err := DecomposeQR(a.Transpose()); err == nil {
+		t.Errorf("expected error for rows < cols")
+	}
+}
+
+func TestLeastSquares(t *testing.T) {
+	// Прямая y = 1 + 2x по точкам с симметричным шумом
+	a := mustFloat(t, [][]float64{{1, 0}, {1, 1}, {1, 2}, {1, 3}})
+	b := []float64{1.1, 2.9, 5.1, 6.9}
+	x, err := LeastSquares(a, b)
+	if err != nil {
+		t.Fatalf("LeastSquares() error = %v", err)
+	}
+	assertVectorClose(t, "coefficients", x, []float64{1.06, 1.96})
+
+	deficient := mustFloat(t, [][]float64{{1, 2}, {2, 4}, {3, 6}})
+	if _, err := LeastSquares(deficient, []float64{1, 2, 3}); !errors.Is(err, ErrRankDeficient) {
+		t.Errorf("LeastSquares() error = %v, want ErrRankDeficient", err)
+	}
+}
+
+func TestCholesky(t *testing.T) {
+	a := mustFloat(t, [][]float64{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}})
+	c, err := DecomposeCholesky(a)
+	if err != nil {
+		t.Fatalf("DecomposeCholesky() error = %v", err)
+	}
+	l := c.L()
+	assertMatrixClose(t, "L", l, mustFloat(t, [][]float64{{2, 0, 0}, {6, 1, 0}, {-8, 5, 3}}))
+	assertMatrixClose(t, "L L^T", multiply(t, l, l.Transpose()), a)
+	if math.Abs(c.Det()-36) > tolerance {
+		t.Errorf("Det() = %v, want 36", c.Det())
+	}
+
+	x, err := c.Solve([]float64{-20, -43, 192})
+	if err != nil {
+		t.Fatalf("Solve() error = %v", err)
+	}
+	assertVectorClose(t, "x", x, []float64{1, 2, 3})
+
+	if _, err := DecomposeCholesky(mustFloat(t, [][]float64{{1, 2}, {3, 4}})); !errors.Is(err, ErrNotSymmetric) {
+		t.Errorf("error = %v, want ErrNotSymmetric", err)
+	}
+	if _, err := DecomposeCholesky(mustFloat(t, [][]float64{{1, 2}, {2, 1}})); !errors.Is(err, ErrNotPositiveDefinite) {
+		t.Errorf("error = %v, want ErrNotPositiveDefinite", err)
+	}
+}
+
+func TestEigenSymmetric(t *testing.T) {
+	a := mustFloat(t, [][]float64{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}})
+	e, err := EigenSymmetric(a)
+	if err != nil {
+		t.Fatalf("EigenSymmetric() error = %v", err)
+	}
+	assertVectorClose(t, "values", e.Values, []float64{2 - math.Sqrt2, 2, 2 + math.Sqrt2})
+
+	// A V = V diag(values)
+	av := multiply(t, a, e.Vectors)
+	scaled := e.Vectors.Clone()
+	scaled.Apply(func(v float64, _, j int) float64 { return v * e.Values[j] })
+	assertMatrixClose(t, "A V", av, scaled)
+
+	identity, _ := Identity(Arithmetic[float64]{}, 3)
+	assertMatrixClose(t, "V^T V", multiply(t, e.Vectors.Transpose(), e.Vectors), identity)
+
+	if _, err := EigenSymmetric(mustFloat(t, [][]float64{{1, 2}, {0, 1}})); !errors.Is(err, ErrNotSymmetric) {
+		t.Errorf("error = %v, want ErrNotSymmetric", err)
+	}
+}
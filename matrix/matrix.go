@@ -5,11 +5,15 @@ import (
 	"reflect"
 )
 
-// Matrix представляет собой двумерную матрицу чисел
+// Matrix представляет собой двумерную матрицу чисел.
+// Элементы хранятся в одном срезе по строкам: элемент (i, j) находится
+// в data[i*stride+j]. Представления (Row, Col, Slice) используют общий
+// срез с исходной матрицей и отличаются от нее только размерами и началом
 type Matrix[T any] struct {
-	rows int
-	cols int
-	data [][]T
+	rows   int
+	cols   int
+	stride int // расстояние между началами соседних строк в data
+	data   []T
 }
 
 // New создает новую матрицу с заданными размерами
@@ -18,17 +22,12 @@ func New[T any](rows, cols int) (*Matrix[T], error) {
 		return nil, errors.New("размеры матрицы должны быть положительными")
 	}
 
-	matrix := &Matrix[T]{
-		rows: rows,
-		cols: cols,
-		data: make([][]T, rows),
-	}
-
-	for i := range matrix.data {
-		matrix.data[i] = make([]T, cols)
-	}
-
-	return matrix, nil
+	return &Matrix[T]{
+		rows:   rows,
+		cols:   cols,
+		stride: cols,
+		data:   make([]T, rows*cols),
+	}, nil
 }
 
 // NewWithValues создает новую матрицу с заданными начальными значениями
@@ -47,33 +46,33 @@ func NewWithValues[T any](values [][]T) (*Matrix[T], error) {
 		}
 	}
 
-	matrix := &Matrix[T]{
-		rows: rows,
-		cols: cols,
-		data: make([][]T, rows),
-	}
-
+	matrix, _ := New[T](rows, cols)
 	for i := range values {
-		matrix.data[i] = make([]T, cols)
-		copy(matrix.data[i], values[i])
+		copy(matrix.row(i), values[i])
 	}
 
 	return matrix, nil
 }
 
-// Clone создает копию матрицы
-func (m *Matrix[T]) Clone() *Matrix[T] {
-	clone := &Matrix[T]{
-		rows: m.rows,
-		cols: m.cols,
-		data: make([][]T, m.rows),
+// NewFromData создает матрицу поверх среза data, записанного по строкам, без копирования:
+// изменения матрицы видны в data и наоборот
+func NewFromData[T any](rows, cols int, data []T) (*Matrix[T], error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, errors.New("размеры матрицы должны быть положительными")
 	}
-
-	for i := range m.data {
-		clone.data[i] = make([]T, m.cols)
-		copy(clone.data[i], m.data[i])
+	if len(data) != rows*cols {
+		return nil, errors.New("длина данных должна быть равна rows*cols")
 	}
+	return &Matrix[T]{rows: rows, cols: cols, stride: cols, data: data}, nil
+}
 
+// Clone создает копию матрицы. Копия представления хранит элементы непрерывно
+// и не связана с исходной матрицей
+func (m *Matrix[T]) Clone() *Matrix[T] {
+	clone, _ := New[T](m.rows, m.cols)
+	for i := 0; i < m.rows; i++ {
+		copy(clone.row(i), m.row(i))
+	}
 	return clone
 }
 
@@ -98,7 +97,7 @@ func (m *Matrix[T]) Get(row, col int) (T, error) {
 	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
 		return zero, errors.New("индексы выходят за пределы матрицы")
 	}
-	return m.data[row][col], nil
+	return m.at(row, col), nil
 }
 
 // Set устанавливает значение элемента по указанным координатам
@@ -106,23 +105,55 @@ func (m *Matrix[T]) Set(row, col int, value T) error {
 	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
 		return errors.New("индексы выходят за пределы матрицы")
 	}
-	m.data[row][col] = value
+	m.set(row, col, value)
 	return nil
 }
 
+// Row возвращает представление строки i размером 1 x cols без копирования
+func (m *Matrix[T]) Row(i int) (*Matrix[T], error) {
+	return m.Slice(i, i+1, 0, m.cols)
+}
+
+// Col возвращает представление столбца j размером rows x 1 без копирования
+func (m *Matrix[T]) Col(j int) (*Matrix[T], error) {
+	return m.Slice(0, m.rows, j, j+1)
+}
+
+// Slice возвращает представление подматрицы из строк [r0, r1) и столбцов [c0, c1).
+// Представление разделяет элементы с исходной матрицей: запись в одно видна в другом
+func (m *Matrix[T]) Slice(r0, r1, c0, c1 int) (*Matrix[T], error) {
+	if r0 < 0 || r1 > m.rows || c0 < 0 || c1 > m.cols {
+		return nil, errors.New("индексы выходят за пределы матрицы")
+	}
+	if r0 >= r1 || c0 >= c1 {
+		return nil, errors.New("размеры матрицы должны быть положительными")
+	}
+
+	start := r0*m.stride + c0
+	end := (r1-1)*m.stride + c1
+	return &Matrix[T]{
+		rows:   r1 - r0,
+		cols:   c1 - c0,
+		stride: m.stride,
+		data:   m.data[start:end:end],
+	}, nil
+}
+
 // Fill заполняет всю матрицу указанным значением
 func (m *Matrix[T]) Fill(value T) {
 	for i := 0; i < m.rows; i++ {
-		for j := 0; j < m.cols; j++ {
-			m.data[i][j] = value
+		row := m.row(i)
+		for j := range row {
+			row[j] = value
 		}
 	}
 }
 
 // Reset обнуляет матрицу (заполняет нулевыми значениями)
 func (m *Matrix[T]) Reset() {
-	var zero T
-	m.Fill(zero)
+	for i := 0; i < m.rows; i++ {
+		clear(m.row(i))
+	}
 }
 
 // IsEqual сравнивает две матрицы на равенство
@@ -133,7 +164,7 @@ func (m *Matrix[T]) IsEqual(other *Matrix[T]) bool {
 
 	for i := 0; i < m.rows; i++ {
 		for j := 0; j < m.cols; j++ {
-			if !reflect.DeepEqual(m.data[i][j], other.data[i][j]) {
+			if !reflect.DeepEqual(m.at(i, j), other.at(i, j)) {
 				return false
 			}
 		}
@@ -145,8 +176,8 @@ func (m *Matrix[T]) IsEqual(other *Matrix[T]) bool {
 // ForEach применяет функцию к каждому элементу матрицы
 func (m *Matrix[T]) ForEach(fn func(T, int, int)) {
 	for i := 0; i < m.rows; i++ {
-		for j := 0; j < m.cols; j++ {
-			fn(m.data[i][j], i, j)
+		for j, v := range m.row(i) {
+			fn(v, i, j)
 		}
 	}
 }
@@ -160,15 +191,19 @@ func (m *Matrix[T]) Add(other *Matrix[T], op func(T, T) T) (*Matrix[T], error) {
 	result, _ := New[T](m.rows, m.cols)
 
 	for i := 0; i < m.rows; i++ {
-		for j := 0; j < m.cols; j++ {
-			result.data[i][j] = op(m.data[i][j], other.data[i][j])
+		a, b, c := m.row(i), other.row(i), result.row(i)
+		for j := range c {
+			c[j] = op(a[j], b[j])
 		}
 	}
 
 	return result, nil
 }
 
-// MultiplyFunc позволяет умножать матрицы, используя предоставленные функции для умножения и сложения
+// Multiply умножает матрицы, используя предоставленные функции для умножения и сложения.
+// Функции вызываются для каждого элемента и для встроенных числовых типов тоже:
+// метод не переключается на быстрое ядро, так как не знает, что делают mulFunc и addFunc.
+// Быстрое блочное умножение встроенных типов - только MultiplyScalar, для полуколец - MultiplySemiring
 func (m *Matrix[T]) Multiply(other *Matrix[T], mulFunc func(T, T) T, addFunc func(T, T) T) (*Matrix[T], error) {
 	if m.cols != other.rows {
 		return nil, errors.New("количество столбцов первой матрицы должно совпадать с количеством строк второй матрицы")
@@ -177,13 +212,16 @@ func (m *Matrix[T]) Multiply(other *Matrix[T], mulFunc func(T, T) T, addFunc fun
 	result, _ := New[T](m.rows, other.cols)
 
 	for i := 0; i < m.rows; i++ {
+		a := m.row(i)
 		for j := 0; j < other.cols; j++ {
-			var sum T // нейтральный элемент для сложения
-			for k := 0; k < m.cols; k++ {
-				product := mulFunc(m.data[i][k], other.data[k][j])
+			// Сумма начинается с первого произведения, а не с нулевого значения T:
+			// для указателей и полуколец вроде (min, +) нулевое значение не нейтрально
+			sum := mulFunc(a[0], other.at(0, j))
+			for k := 1; k < len(a); k++ {
+				product := mulFunc(a[k], other.at(k, j))
 				sum = addFunc(sum, product)
 			}
-			result.data[i][j] = sum
+			result.set(i, j, sum)
 		}
 	}
 
@@ -194,8 +232,8 @@ func (m *Matrix[T]) Multiply(other *Matrix[T], mulFunc func(T, T) T, addFunc fun
 func (m *Matrix[T]) Transpose() *Matrix[T] {
 	result, _ := New[T](m.cols, m.rows)
 	for i := 0; i < m.rows; i++ {
-		for j := 0; j < m.cols; j++ {
-			result.data[j][i] = m.data[i][j]
+		for j, v := range m.row(i) {
+			result.set(j, i, v)
 		}
 	}
 	return result
@@ -204,8 +242,33 @@ func (m *Matrix[T]) Transpose() *Matrix[T] {
 // Apply применяет функцию к каждому элементу, изменяя матрицу
 func (m *Matrix[T]) Apply(fn func(T, int, int) T) {
 	for i := 0; i < m.rows; i++ {
-		for j := 0; j < m.cols; j++ {
-			m.data[i][j] = fn(m.data[i][j], i, j)
+		row := m.row(i)
+		for j, v := range row {
+			row[j] = fn(v, i, j)
 		}
 	}
 }
+
+// at возвращает элемент без проверки индексов
+func (m *Matrix[T]) at(i, j int) T {
+	return m.data[i*m.stride+j]
+}
+
+// set записывает элемент без проверки индексов
+func (m *Matrix[T]) set(i, j int, value T) {
+	m.data[i*m.stride+j] = value
+}
+
+// row возвращает строку i как срез общего хранилища
+func (m *Matrix[T]) row(i int) []T {
+	start := i * m.stride
+	return m.data[start : start+m.cols : start+m.cols]
+}
+
+// swapRows меняет местами строки i и j
+func (m *Matrix[T]) swapRows(i, j int) {
+	a, b := m.row(i), m.row(j)
+	for k := range a {
+		a[k], b[k] = b[k], a[k]
+	}
+}
@@ -0,0 +1,275 @@
+package matrix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"types/cast"
+)
+
+// Sparse - общий интерфейс форматов COO, CSR и CSC
+type Sparse[T Scalar] interface {
+	Size() (int, int)
+	NNZ() int
+	ForEach(fn func(T, int, int))
+	ToDense() *Matrix[T]
+}
+
+// mtxHeader - разобранная строка-баннер Matrix Market
+// "%%MatrixMarket matrix <format> <field> <symmetry>"
+type mtxHeader struct {
+	format   string // coordinate или array
+	field    string // real, integer, pattern
+	symmetry string // general, symmetric, skew-symmetric
+}
+
+// mtxReader читает строки данных Matrix Market, пропуская комментарии и пустые строки,
+// и помнит номер строки файла для сообщений об ошибках
+type mtxReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// next возвращает поля следующей строки данных или io.ErrUnexpectedEOF
+func (r *mtxReader) next() ([]string, error) {
+	for r.scanner.Scan() {
+		r.line++
+		text := strings.TrimSpace(r.scanner.Text())
+		if text == "" || strings.HasPrefix(text, "%") {
+			continue
+		}
+		return strings.Fields(text), nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.ErrUnexpectedEOF
+}
+
+// errorf создает ошибку с номером строки файла
+func (r *mtxReader) errorf(format string, args ...any) error {
+	return fmt.Errorf("matrix market, строка %d: %s", r.line, fmt.Sprintf(format, args...))
+}
+
+// readMtxHeader разбирает баннер и строку размеров. Для формата coordinate
+// возвращает число записей, для array - rows*cols
+func readMtxHeader(in io.Reader) (*mtxReader, mtxHeader, int, int, int, error) {
+	r := &mtxReader{scanner: bufio.NewScanner(in)}
+	var h mtxHeader
+
+	if !r.scanner.Scan() {
+		return nil, h, 0, 0, 0, fmt.Errorf("matrix market: пустой ввод")
+	}
+	r.line++
+	banner := strings.Fields(strings.ToLower(r.scanner.Text()))
+	if len(banner) != 5 || banner[0] != "%%matrixmarket" || banner[1] != "matrix" {
+		return nil, h, 0, 0, 0, r.errorf("ожидался заголовок %%%%MatrixMarket matrix ...")
+	}
+	h = mtxHeader{format: banner[2], field: banner[3], symmetry: banner[4]}
+
+	switch {
+	case h.format != "coordinate" && h.format != "array":
+		return nil, h, 0, 0, 0, r.errorf("неподдерживаемый формат %q", h.format)
+	case h.field != "real" && h.field != "integer" && h.field != "pattern":
+		return nil, h, 0, 0, 0, r.errorf("неподдерживаемый тип значений %q", h.field)
+	case h.symmetry != "general" && h.symmetry != "symmetric" && h.symmetry != "skew-symmetric":
+		return nil, h, 0, 0, 0, r.errorf("неподдерживаемая симметрия %q", h.symmetry)
+	case h.format == "array" && h.field == "pattern":
+		return nil, h, 0, 0, 0, r.errorf("формат array не допускает тип pattern")
+	}
+
+	fields, err := r.next()
+	if err != nil {
+		return nil, h, 0, 0, 0, r.errorf("нет строки размеров")
+	}
+	want := 3
+	if h.format == "array" {
+		want = 2
+	}
+	if len(fields) != want {
+		return nil, h, 0, 0, 0, r.errorf("строка размеров должна содержать %d числа", want)
+	}
+	sizes := make([]int, want)
+	for i, f := range fields {
+		sizes[i], err = strconv.Atoi(f)
+		if err != nil || sizes[i] < 0 {
+			return nil, h, 0, 0, 0, r.errorf("неверный размер %q", f)
+		}
+	}
+	rows, cols := sizes[0], sizes[1]
+	if rows == 0 || cols == 0 {
+		return nil, h, 0, 0, 0, r.errorf("размеры матрицы должны быть положительными")
+	}
+	if h.symmetry != "general" && rows != cols {
+		return nil, h, 0, 0, 0, r.errorf("симметричная матрица должна быть квадратной")
+	}
+
+	if cols > math.MaxInt/rows {
+		return nil, h, 0, 0, 0, r.errorf("размеры %d x %d слишком велики", rows, cols)
+	}
+
+	entries := rows * cols
+	if h.format == "coordinate" {
+		entries = sizes[2]
+	}
+	return r, h, rows, cols, entries, nil
+}
+
+// ReadMatrixMarket читает разреженную матрицу в формате Matrix Market (.mtx).
+// Поддерживаются форматы coordinate и array, типы real, integer и pattern
+// (значение 1), симметрии general, symmetric и skew-symmetric (вторая половина
+// восстанавливается). Значения разбираются через cast в строгом режиме
+func ReadMatrixMarket[T Scalar](in io.Reader) (*COO[T], error) {
+	r, h, rows, cols, entries, err := readMtxHeader(in)
+	if err != nil {
+		return nil, err
+	}
+
+	coo := &COO[T]{rows: rows, cols: cols}
+	err = readMtxEntries(r, h, rows, cols, entries, func(i, j int, v T) {
+		coo.row, coo.col, coo.val = append(coo.row, i), append(coo.col, j), append(coo.val, v)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return coo, nil
+}
+
+// readMtxEntries читает данные после строки размеров и передает каждый элемент
+// (индексы с нуля) в add, восстанавливая вторую половину симметричных матриц
+func readMtxEntries[T Scalar](r *mtxReader, h mtxHeader, rows, cols, entries int, add func(i, j int, v T)) error {
+	emit := func(i, j int, v T) {
+		add(i, j, v)
+		if i != j {
+			switch h.symmetry {
+			case "symmetric":
+				add(j, i, v)
+			case "skew-symmetric":
+				add(j, i, -v)
+			}
+		}
+	}
+
+	if h.format == "array" {
+		// Значения по столбцам; для симметричных матриц - только нижний треугольник
+		for j := 0; j < cols; j++ {
+			start := 0
+			switch h.symmetry {
+			case "symmetric":
+				start = j
+			case "skew-symmetric":
+				start = j + 1
+			}
+			for i := start; i < rows; i++ {
+				v, err := readMtxValue[T](r, i, j)
+				if err != nil {
+					return err
+				}
+				if v != 0 {
+					emit(i, j, v)
+				}
+			}
+		}
+		return nil
+	}
+
+	for k := 0; k < entries; k++ {
+		fields, err := r.next()
+		if err != nil {
+			return r.errorf("ожидалось %d записей, прочитано %d", entries, k)
+		}
+		want := 3
+		if h.field == "pattern" {
+			want = 2
+		}
+		if len(fields) != want {
+			return r.errorf("запись должна содержать %d поля", want)
+		}
+
+		i, errRow := strconv.Atoi(fields[0])
+		j, errCol := strconv.Atoi(fields[1])
+		if errRow != nil || errCol != nil || i < 1 || i > rows || j < 1 || j > cols {
+			return r.errorf("неверные индексы (%s, %s)", fields[0], fields[1])
+		}
+
+		var v T = 1
+		if h.field != "pattern" {
+			v, err = parseElement[T](fields[2])
+			if err != nil {
+				return r.errorf("элемент (%d, %d): %v", i, j, err)
+			}
+		}
+		emit(i-1, j-1, v)
+	}
+	return nil
+}
+
+// readMtxValue читает одно значение формата array
+func readMtxValue[T Scalar](r *mtxReader, i, j int) (T, error) {
+	fields, err := r.next()
+	if err != nil {
+		return 0, r.errorf("не хватает значений: нет элемента (%d, %d)", i+1, j+1)
+	}
+	if len(fields) != 1 {
+		return 0, r.errorf("строка должна содержать одно значение")
+	}
+	v, err := parseElement[T](fields[0])
+	if err != nil {
+		return 0, r.errorf("элемент (%d, %d): %v", i+1, j+1, err)
+	}
+	return v, nil
+}
+
+// WriteMatrixMarket записывает разреженную матрицу в формате Matrix Market
+// coordinate general. Повторы COO записываются как есть, индексы начинаются с 1
+func WriteMatrixMarket[T Scalar](w io.Writer, m Sparse[T]) error {
+	bw := bufio.NewWriter(w)
+	rows, cols := m.Size()
+	fmt.Fprintf(bw, "%%%%MatrixMarket matrix coordinate %s general\n", mtxField[T]())
+	fmt.Fprintf(bw, "%d %d %d\n", rows, cols, m.NNZ())
+	m.ForEach(func(v T, i, j int) {
+		fmt.Fprintf(bw, "%d %d %s\n", i+1, j+1, formatScalar(v))
+	})
+	return bw.Flush()
+}
+
+// parseElement разбирает элемент матрицы через cast в строгом режиме:
+// "1.5" не превратится в целое 1, а "abc" даст ошибку вместо нуля.
+// Для вещественных T принимаются и NaN, +Inf, -Inf, которые пишет formatScalar
+func parseElement[T Scalar](value any) (T, error) {
+	if s, ok := value.(string); ok && mtxField[T]() == "real" {
+		switch s {
+		case "NaN", "+Inf", "-Inf":
+			f, _ := strconv.ParseFloat(s, 64)
+			return T(f), nil
+		}
+	}
+	return cast.ToWithOptions[T](value, cast.Options{Mode: cast.Strict})
+}
+
+// mtxField возвращает тип значений Matrix Market для T
+func mtxField[T Scalar]() string {
+	half := 0.5
+	if T(half) == 0 {
+		return "integer"
+	}
+	return "real"
+}
+
+// formatScalar форматирует число в кратчайшем виде без потери точности
+func formatScalar[T Scalar](v T) string {
+	switch x := any(v).(type) {
+	case float32:
+		return strconv.FormatFloat(float64(x), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(x, 'g', -1, 64)
+	}
+	if mtxField[T]() == "integer" {
+		return strconv.FormatInt(int64(v), 10)
+	}
+	return strconv.FormatFloat(float64(v), 'g', -1, 64)
+}
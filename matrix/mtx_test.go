@@ -0,0 +1,102 @@
+package matrix
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestMatrixMarketRoundTrip(t *testing.T) {
+	dense, _ := NewWithValues([][]float64{{1.5, 0, 0}, {0, 0, -2}, {0, 1e-3, 0}})
+	var buf bytes.Buffer
+	if err := WriteMatrixMarket(&buf, DenseToCSR(dense)); err != nil {
+		t.Fatalf("WriteMatrixMarket() error = %v", err)
+	}
+
+	want := "%%MatrixMarket matrix coordinate real general\n3 3 3\n1 1 1.5\n2 3 -2\n3 2 0.001\n"
+	if buf.String() != want {
+		t.Errorf("WriteMatrixMarket() = %q, want %q", buf.String(), want)
+	}
+
+	coo, err := ReadMatrixMarket[float64](&buf)
+	if err != nil {
+		t.Fatalf("ReadMatrixMarket() error = %v", err)
+	}
+	if !coo.ToDense().IsEqual(dense) {
+		t.Errorf("round trip = %v", coo.ToDense().data)
+	}
+
+	var ints bytes.Buffer
+	m, _ := NewWithValues([][]int{{0, 7}, {0, 0}})
+	_ = WriteMatrixMarket(&ints, DenseToCOO(m))
+	if !strings.HasPrefix(ints.String(), "%%MatrixMarket matrix coordinate integer general\n") {
+		t.Errorf("integer header = %q", ints.String())
+	}
+}
+
+func TestReadMatrixMarketVariants(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  [][]float64
+	}{
+		{
+			"symmetric with comments",
+			"%%MatrixMarket matrix coordinate real symmetric\n% comment\n\n2 2 2\n1 1 4\n2 1 -1\n",
+			[][]float64{{4, -1}, {-1, 0}},
+		},
+		{
+			"skew-symmetric",
+			"%%MatrixMarket matrix coordinate integer skew-symmetric\n2 2 1\n2 1 3\n",
+			[][]float64{{0, -3}, {3, 0}},
+		},
+		{
+			"pattern",
+			"%%MatrixMarket matrix coordinate pattern general\n2 3 2\n1 3\n2 1\n",
+			[][]float64{{0, 0, 1}, {1, 0, 0}},
+		},
+		{
+			"array column-major",
+			"%%MatrixMarket matrix array real general\n2 2\n1\n2\n3\n4\n",
+			[][]float64{{1, 3}, {2, 4}},
+		},
+		{
+			"array symmetric",
+			"%%MatrixMarket matrix array real symmetric\n2 2\n1\n2\n3\n",
+			[][]float64{{1, 2}, {2, 3}},
+		},
+	}
+	for _, tt := range tests {
+		coo, err := ReadMatrixMarket[float64](strings.NewReader(tt.input))
+		if err != nil {
+			t.Errorf("%s: error = %v", tt.name, err)
+			continue
+		}
+		want, _ := NewWithValues(tt.want)
+		if got := coo.ToDense(); !got.IsEqual(want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got.data, tt.want)
+		}
+	}
+}
+
+func TestReadMatrixMarketErrors(t *testing.T) {
+	tests := []struct {
+		name, input, wantInError string
+	}{
+		{"empty", "", "пустой"},
+		{"bad banner", "%%Matrix\n", "строка 1"},
+		{"complex", "%%MatrixMarket matrix coordinate complex general\n", "complex"},
+		{"bad value", "%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1 abc\n", "строка 3"},
+		{"index out of range", "%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n", "строка 3"},
+		{"too few entries", "%%MatrixMarket matrix coordinate real general\n2 2 2\n1 1 1\n", "прочитано 1"},
+		{"short array", "%%MatrixMarket matrix array real general\n2 1\n1\n", "(2, 1)"},
+		{"size overflow", "%%MatrixMarket matrix coordinate real general\n4294967296 4294967296 1\n1 1 1\n", "строка 2: размеры"},
+		{"product overflow", "%%MatrixMarket matrix array real general\n3037000500 3037000500\n", "строка 2: размеры"},
+	}
+	for _, tt := range tests {
+		_, err := ReadMatrixMarket[float64](strings.NewReader(tt.input))
+		if err == nil || !strings.Contains(err.Error(), tt.wantInError) {
+			t.Errorf("%s: error = %v, want it to contain %q", tt.name, err, tt.wantInError)
+		}
+	}
+}
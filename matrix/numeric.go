@@ -0,0 +1,53 @@
+package matrix
+
+import (
+	"errors"
+
+	"types/numeric"
+)
+
+// AddNumeric складывает матрицы чисел, реализующих numeric.Number
+// (*big.Big, *rational.Rational, complex.Complex)
+func AddNumeric[T numeric.Number[T]](a, b *Matrix[T]) (*Matrix[T], error) {
+	return a.Add(b, T.Add)
+}
+
+// MultiplyNumeric перемножает матрицы чисел, реализующих numeric.Number.
+// Это MultiplySemiring в кольце NumericRing[T]
+func MultiplyNumeric[T numeric.Number[T]](a, b *Matrix[T]) (*Matrix[T], error) {
+	return MultiplySemiring(NumericRing[T]{}, a, b)
+}
+
+// DetNumeric вычисляет определитель квадратной матрицы методом Гаусса.
+// Для *rational.Rational результат точный
+func DetNumeric[T numeric.Number[T]](m *Matrix[T]) (T, error) {
+	if m.rows != m.cols {
+		return numeric.Zero[T](), errors.New("определитель определен только для квадратной матрицы")
+	}
+
+	a := m.Clone()
+	det := numeric.One[T]()
+	for col := 0; col < a.cols; col++ {
+		// Ищем ненулевой ведущий элемент
+		pivot := col
+		for pivot < a.rows && numeric.IsZero(a.at(pivot, col)) {
+			pivot++
+		}
+		if pivot == a.rows {
+			return numeric.Zero[T](), nil
+		}
+		if pivot != col {
+			a.swapRows(pivot, col)
+			det = numeric.Zero[T]().Subtract(det)
+		}
+
+		det = det.Multiply(a.at(col, col))
+		for row := col + 1; row < a.rows; row++ {
+			factor := a.at(row, col).Divide(a.at(col, col))
+			for k := col; k < a.cols; k++ {
+				a.set(row, k, a.at(row, k).Subtract(factor.Multiply(a.at(col, k))))
+			}
+		}
+	}
+	return det, nil
+}
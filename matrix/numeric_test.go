@@ -0,0 +1,83 @@
+package matrix
+
+import (
+	"testing"
+
+	"types/big"
+	"types/complex"
+	"types/rational"
+)
+
+func TestMultiplyNumeric_Rational(t *testing.T) {
+	a, _ := NewWithValues([][]*rational.Rational{
+		{rational.New(1, 2), rational.New(1, 3)},
+		{rational.NewFromInt(0), rational.NewFromInt(1)},
+	})
+	b, _ := NewWithValues([][]*rational.Rational{
+		{rational.NewFromInt(2), rational.NewFromInt(0)},
+		{rational.NewFromInt(3), rational.New(1, 4)},
+	})
+
+	product, err := MultiplyNumeric(a, b)
+	if err != nil {
+		t.Fatalf("MultiplyNumeric() error = %v", err)
+	}
+	want := [][]*rational.Rational{
+		{rational.NewFromInt(2), rational.New(1, 12)},
+		{rational.NewFromInt(3), rational.New(1, 4)},
+	}
+	for i := range want {
+		for j := range want[i] {
+			got, _ := product.Get(i, j)
+			if !got.Equals(want[i][j]) {
+				t.Errorf("product[%d][%d] = %s, want %s", i, j, got, want[i][j])
+			}
+		}
+	}
+}
+
+func TestAddNumeric_Big(t *testing.T) {
+	a, _ := NewWithValues([][]*big.Big{{big.New("0.1"), big.New(1)}})
+	b, _ := NewWithValues([][]*big.Big{{big.New("0.2"), big.New("2.5")}})
+
+	sum, err := AddNumeric(a, b)
+	if err != nil {
+		t.Fatalf("AddNumeric() error = %v", err)
+	}
+	if got, _ := sum.Get(0, 0); got.String() != "0.3" {
+		t.Errorf("sum[0][0] = %s, want 0.3", got)
+	}
+	if got, _ := sum.Get(0, 1); got.String() != "3.5" {
+		t.Errorf("sum[0][1] = %s, want 3.5", got)
+	}
+}
+
+func TestDetNumeric(t *testing.T) {
+	m, _ := NewWithValues([][]*rational.Rational{
+		{rational.NewFromInt(0), rational.NewFromInt(2), rational.NewFromInt(1)},
+		{rational.New(1, 2), rational.NewFromInt(1), rational.NewFromInt(0)},
+		{rational.NewFromInt(3), rational.NewFromInt(0), rational.NewFromInt(1)},
+	})
+	det, err := DetNumeric(m)
+	if err != nil {
+		t.Fatalf("DetNumeric() error = %v", err)
+	}
+	// 0*(1-0) - 2*(1/2-0) + 1*(0-3) = -4
+	if !det.Equals(rational.NewFromInt(-4)) {
+		t.Errorf("DetNumeric() = %s, want -4", det)
+	}
+
+	c, _ := NewWithValues([][]complex.Complex{
+		{complex.New(1, 1), complex.New(2, 0)},
+		{complex.New(0, 1), complex.New(1, -1)},
+	})
+	cdet, _ := DetNumeric(c)
+	// (1+i)(1-i) - 2i = 2 - 2i
+	if !cdet.Equals(complex.New(2, -2), 1e-12) {
+		t.Errorf("DetNumeric() = %s, want 2-2i", cdet)
+	}
+
+	if _, err := DetNumeric(&Matrix[*rational.Rational]{rows: 1, cols: 2}); err == nil {
+		t.Errorf("DetNumeric() expected error for non-square matrix")
+	}
+}
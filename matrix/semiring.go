@@ -0,0 +1,182 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+
+	"types/numeric"
+)
+
+// Semiring задает алгебру, над которой умножаются матрицы: сложение с нейтральным
+// элементом Zero и умножение с нейтральным элементом One. Zero должен поглощать
+// при умножении (Mul(Zero, x) = Zero), иначе произведение матриц теряет смысл
+type Semiring[T any] interface {
+	Zero() T
+	One() T
+	Add(a, b T) T
+	Mul(a, b T) T
+}
+
+// Ring - полукольцо с противоположными по сложению элементами
+type Ring[T any] interface {
+	Semiring[T]
+	Neg(a T) T
+}
+
+// ErrNegativeCycle возвращается ShortestPaths, если граф содержит цикл отрицательного веса
+var ErrNegativeCycle = errors.New("граф содержит цикл отрицательного веса")
+
+// Arithmetic - обычное кольцо встроенных чисел (+, *)
+type Arithmetic[T Scalar] struct{}
+
+func (Arithmetic[T]) Zero() T      { return 0 }
+func (Arithmetic[T]) One() T       { return 1 }
+func (Arithmetic[T]) Add(a, b T) T { return a + b }
+func (Arithmetic[T]) Mul(a, b T) T { return a * b }
+func (Arithmetic[T]) Neg(a T) T    { return -a }
+
+// NumericRing - кольцо чисел проекта: *big.Big, *rational.Rational, complex.Complex.
+// Нейтральные элементы берутся из T.Zero и T.One, а не из нулевого значения типа
+type NumericRing[T numeric.Number[T]] struct{}
+
+func (NumericRing[T]) Zero() T      { return numeric.Zero[T]() }
+func (NumericRing[T]) One() T       { return numeric.One[T]() }
+func (NumericRing[T]) Add(a, b T) T { return a.Add(b) }
+func (NumericRing[T]) Mul(a, b T) T { return a.Multiply(b) }
+func (NumericRing[T]) Neg(a T) T    { return numeric.Zero[T]().Subtract(a) }
+
+// Boolean - булево полукольцо (или, и). Произведение матриц смежности
+// показывает наличие путей, степень k - путей длины k
+type Boolean struct{}
+
+func (Boolean) Zero() bool         { return false }
+func (Boolean) One() bool          { return true }
+func (Boolean) Add(a, b bool) bool { return a || b }
+func (Boolean) Mul(a, b bool) bool { return a && b }
+
+// MinPlus - тропическое полукольцо (min, +): Zero = +Inf (нет ребра), One = 0.
+// Произведение матриц весов дает кратчайшие пути через одну промежуточную вершину
+type MinPlus struct{}
+
+func (MinPlus) Zero() float64            { return math.Inf(1) }
+func (MinPlus) One() float64             { return 0 }
+func (MinPlus) Add(a, b float64) float64 { return min(a, b) }
+
+// Mul складывает веса; +Inf поглощает и отрицательную бесконечность
+func (MinPlus) Mul(a, b float64) float64 {
+	if math.IsInf(a, 1) || math.IsInf(b, 1) {
+		return math.Inf(1)
+	}
+	return a + b
+}
+
+// MaxPlus - тропическое полукольцо (max, +): Zero = -Inf, One = 0.
+// Используется для самых длинных путей в ациклических графах и задач расписаний
+type MaxPlus struct{}
+
+func (MaxPlus) Zero() float64            { return math.Inf(-1) }
+func (MaxPlus) One() float64             { return 0 }
+func (MaxPlus) Add(a, b float64) float64 { return max(a, b) }
+
+// Mul складывает веса; -Inf поглощает и положительную бесконечность
+func (MaxPlus) Mul(a, b float64) float64 {
+	if math.IsInf(a, -1) || math.IsInf(b, -1) {
+		return math.Inf(-1)
+	}
+	return a + b
+}
+
+// Identity возвращает единичную матрицу n x n в полукольце s:
+// One на диагонали и Zero вне ее
+func Identity[T any](s Semiring[T], n int) (*Matrix[T], error) {
+	m, err := New[T](n, n)
+	if err != nil {
+		return nil, err
+	}
+	zero, one := s.Zero(), s.One()
+	for i := 0; i < n; i++ {
+		row := m.row(i)
+		for j := range row {
+			row[j] = zero
+		}
+		row[i] = one
+	}
+	return m, nil
+}
+
+// AddSemiring складывает матрицы поэлементно в полукольце s
+func AddSemiring[T any](s Semiring[T], a, b *Matrix[T]) (*Matrix[T], error) {
+	return a.Add(b, s.Add)
+}
+
+// MultiplySemiring перемножает матрицы в полукольце s; каждая сумма начинается с s.Zero()
+func MultiplySemiring[T any](s Semiring[T], a, b *Matrix[T]) (*Matrix[T], error) {
+	if a.cols != b.rows {
+		return nil, errors.New("количество столбцов первой матрицы должно совпадать с количеством строк второй матрицы")
+	}
+
+	result, _ := New[T](a.rows, b.cols)
+	for i := 0; i < a.rows; i++ {
+		x, c := a.row(i), result.row(i)
+		for j := range c {
+			sum := s.Zero()
+			for k, v := range x {
+				sum = s.Add(sum, s.Mul(v, b.at(k, j)))
+			}
+			c[j] = sum
+		}
+	}
+	return result, nil
+}
+
+// Pow возводит квадратную матрицу в степень n >= 0 в полукольце s двоичным
+// возведением; степень 0 - единичная матрица
+func Pow[T any](s Semiring[T], m *Matrix[T], n int) (*Matrix[T], error) {
+	if m.rows != m.cols {
+		return nil, ErrNotSquare
+	}
+	if n < 0 {
+		return nil, errors.New("степень матрицы должна быть неотрицательной")
+	}
+
+	result, _ := Identity(s, m.rows)
+	base := m
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result, _ = MultiplySemiring(s, result, base)
+		}
+		if n > 1 {
+			base, _ = MultiplySemiring(s, base, base)
+		}
+	}
+	return result, nil
+}
+
+// ShortestPaths находит длины кратчайших путей между всеми парами вершин
+// возведением матрицы весов в степень в полукольце (min, +).
+// weights[i][j] - вес ребра i -> j, +Inf - ребра нет. Диагональ считается не больше нуля.
+// Сложность O(n^3 log n); при цикле отрицательного веса возвращается ErrNegativeCycle
+func ShortestPaths(weights *Matrix[float64]) (*Matrix[float64], error) {
+	if weights.rows != weights.cols {
+		return nil, ErrNotSquare
+	}
+
+	// D = I ⊕ W: путь из вершины в себя без ребер имеет длину 0
+	s := MinPlus{}
+	identity, _ := Identity(s, weights.rows)
+	distances, _ := AddSemiring(s, identity, weights)
+
+	// Кратчайший путь без циклов содержит не больше n-1 ребер: возводим в квадрат до покрытия
+	for steps := 1; steps < weights.rows-1; steps *= 2 {
+		distances, _ = MultiplySemiring(s, distances, distances)
+	}
+
+	// Еще одно возведение уменьшает диагональ только при отрицательном цикле
+	check, _ := MultiplySemiring(s, distances, distances)
+	for i := 0; i < weights.rows; i++ {
+		if check.at(i, i) < 0 {
+			return nil, ErrNegativeCycle
+		}
+	}
+	return distances, nil
+}
@@ -0,0 +1,175 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	bignum "types/big"
+	"types/complex"
+	"types/rational"
+)
+
+func TestMultiplyPointerElements(t *testing.T) {
+	// Раньше сумма начиналась с nil и метод паниковал на указателях
+	a, _ := NewWithValues([][]*bignum.Big{
+		{bignum.New("1.5"), bignum.New(2)},
+		{bignum.New(0), bignum.New(-1)},
+	})
+	product, err := a.Multiply(a, (*bignum.Big).Multiply, (*bignum.Big).Add)
+	if err != nil {
+		t.Fatalf("Multiply() error = %v", err)
+	}
+	if v, _ := product.Get(0, 1); v.Compare(bignum.New(1)) != 0 {
+		t.Errorf("product[0][1] = %v, want 1", v)
+	}
+
+	semiring, _ := MultiplySemiring(NumericRing[*bignum.Big]{}, a, a)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			x, _ := product.Get(i, j)
+			y, _ := semiring.Get(i, j)
+			if x.Compare(y) != 0 {
+				t.Errorf("Multiply and MultiplySemiring differ at [%d][%d]: %v != %v", i, j, x, y)
+			}
+		}
+	}
+}
+
+func TestPow(t *testing.T) {
+	// Числа Фибоначчи: [[1 1] [1 0]]^n = [[F(n+1) F(n)] [F(n) F(n-1)]]
+	fib, _ := NewWithValues([][]int{{1, 1}, {1, 0}})
+	p, err := Pow(Arithmetic[int]{}, fib, 30)
+	if err != nil {
+		t.Fatalf("Pow() error = %v", err)
+	}
+	if v, _ := p.Get(0, 1); v != 832040 {
+		t.Errorf("F(30) = %d, want 832040", v)
+	}
+
+	identity, _ := Identity(Arithmetic[int]{}, 2)
+	if p, _ := Pow(Arithmetic[int]{}, fib, 0); !p.IsEqual(identity) {
+		t.Errorf("Pow(m, 0) should be identity")
+	}
+
+	half, _ := NewWithValues([][]*rational.Rational{
+		{rational.New(1, 2), rational.New(0, 1)},
+		{rational.New(1, 1), rational.New(1, 3)},
+	})
+	cube, _ := Pow(NumericRing[*rational.Rational]{}, half, 3)
+	if v, _ := cube.Get(0, 0); !v.Equals(rational.New(1, 8)) {
+		t.Errorf("Pow()[0][0] = %v, want 1/8", v)
+	}
+	// (1/4 + 1/6 + 1/9) = 19/36
+	if v, _ := cube.Get(1, 0); !v.Equals(rational.New(19, 36)) {
+		t.Errorf("Pow()[1][0] = %v, want 19/36", v)
+	}
+
+	rotation, _ := NewWithValues([][]complex.Complex{{complex.New(0, 1)}})
+	if p, _ := Pow(NumericRing[complex.Complex]{}, rotation, 4); !p.IsEqual(mustComplex(t, 1)) {
+		t.Errorf("i^4 = %v, want 1", p.data)
+	}
+
+	if _, err := Pow(Arithmetic[int]{}, fib, -1); err == nil {
+		t.Errorf("expected error for negative power")
+	}
+	rect, _ := New[int](2, 3)
+	if _, err := Pow(Arithmetic[int]{}, rect, 2); !errors.Is(err, ErrNotSquare) {
+		t.Errorf("Pow() error = %v, want ErrNotSquare", err)
+	}
+}
+
+func mustComplex(t *testing.T, re float64) *Matrix[complex.Complex] {
+	t.Helper()
+	m, _ := NewWithValues([][]complex.Complex{{complex.New(re, 0)}})
+	return m
+}
+
+func TestIdentityAndRing(t *testing.T) {
+	id, _ := Identity(NumericRing[*rational.Rational]{}, 2)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			v, _ := id.Get(i, j)
+			if v == nil || v.IsZero() == (i == j) {
+				t.Errorf("Identity[%d][%d] = %v", i, j, v)
+			}
+		}
+	}
+
+	var ring Ring[*rational.Rational] = NumericRing[*rational.Rational]{}
+	if v := ring.Neg(rational.New(1, 2)); !v.Equals(rational.New(-1, 2)) {
+		t.Errorf("Neg() = %v", v)
+	}
+	if v := (Arithmetic[float64]{}).Neg(2); v != -2 {
+		t.Errorf("Neg() = %v", v)
+	}
+}
+
+func TestBooleanReachability(t *testing.T) {
+	// 0 -> 1 -> 2, пути длины 2 есть только из 0 в 2
+	adjacency, _ := NewWithValues([][]bool{
+		{false, true, false},
+		{false, false, true},
+		{false, false, false},
+	})
+	paths, _ := Pow(Boolean{}, adjacency, 2)
+	want, _ := NewWithValues([][]bool{
+		{false, false, true},
+		{false, false, false},
+		{false, false, false},
+	})
+	if !paths.IsEqual(want) {
+		t.Errorf("paths of length 2 = %v", paths.data)
+	}
+}
+
+func TestShortestPaths(t *testing.T) {
+	inf := math.Inf(1)
+	weights, _ := NewWithValues([][]float64{
+		{0, 3, inf, 7},
+		{8, 0, 2, inf},
+		{5, inf, 0, 1},
+		{2, inf, inf, 0},
+	})
+	distances, err := ShortestPaths(weights)
+	if err != nil {
+		t.Fatalf("ShortestPaths() error = %v", err)
+	}
+	want, _ := NewWithValues([][]float64{
+		{0, 3, 5, 6},
+		{5, 0, 2, 3},
+		{3, 6, 0, 1},
+		{2, 5, 7, 0},
+	})
+	if !distances.IsEqual(want) {
+		t.Errorf("ShortestPaths() = %v", distances.data)
+	}
+
+	// Недостижимые вершины остаются +Inf
+	disconnected, _ := NewWithValues([][]float64{{0, inf}, {inf, 0}})
+	if d, _ := ShortestPaths(disconnected); !math.IsInf(d.at(0, 1), 1) {
+		t.Errorf("unreachable distance = %v, want +Inf", d.at(0, 1))
+	}
+
+	negative, _ := NewWithValues([][]float64{{0, 1, inf}, {inf, 0, -3}, {1, inf, 0}})
+	if _, err := ShortestPaths(negative); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("ShortestPaths() error = %v, want ErrNegativeCycle", err)
+	}
+}
+
+func TestMaxPlus(t *testing.T) {
+	// Самый длинный путь из 0 в 2 за два шага: 0 -> 1 -> 2 с весом 3 + 4
+	ninf := math.Inf(-1)
+	weights, _ := NewWithValues([][]float64{
+		{ninf, 3, 5},
+		{ninf, ninf, 4},
+		{ninf, ninf, ninf},
+	})
+	two, _ := Pow(MaxPlus{}, weights, 2)
+	if v, _ := two.Get(0, 2); v != 7 {
+		t.Errorf("longest 2-step path = %v, want 7", v)
+	}
+	if v, _ := two.Get(1, 0); !math.IsInf(v, -1) {
+		t.Errorf("missing path = %v, want -Inf", v)
+	}
+}
@@ -0,0 +1,313 @@
+package matrix
+
+import (
+	"errors"
+	"slices"
+	"sort"
+)
+
+// COO - разреженная матрица в координатном формате: список троек (строка, столбец, значение).
+// Удобна для построения; повторяющиеся позиции допускаются и суммируются при переводе в CSR/CSC
+type COO[T Scalar] struct {
+	rows, cols int
+	row, col   []int
+	val        []T
+}
+
+// CSR - разреженная матрица со сжатыми строками: ненулевые элементы строки i
+// лежат в indices/values[indptr[i]:indptr[i+1]] с возрастающими номерами столбцов.
+// Быстрые операции: доступ к строке, умножение на вектор
+type CSR[T Scalar] struct {
+	rows, cols int
+	indptr     []int
+	indices    []int
+	values     []T
+}
+
+// CSC - разреженная матрица со сжатыми столбцами: то же, что CSR, но по столбцам.
+// Быстрые операции: доступ к столбцу, умножение транспонированной матрицы
+type CSC[T Scalar] struct {
+	rows, cols int
+	indptr     []int
+	indices    []int
+	values     []T
+}
+
+// NewCOO создает разреженную матрицу из троек: элемент (rowIdx[k], colIdx[k]) равен values[k]
+func NewCOO[T Scalar](rows, cols int, rowIdx, colIdx []int, values []T) (*COO[T], error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, errors.New("размеры матрицы должны быть положительными")
+	}
+	if len(rowIdx) != len(values) || len(colIdx) != len(values) {
+		return nil, errors.New("количество индексов и значений должно совпадать")
+	}
+	for k := range values {
+		if rowIdx[k] < 0 || rowIdx[k] >= rows || colIdx[k] < 0 || colIdx[k] >= cols {
+			return nil, errors.New("индексы выходят за пределы матрицы")
+		}
+	}
+	return &COO[T]{
+		rows: rows,
+		cols: cols,
+		row:  slices.Clone(rowIdx),
+		col:  slices.Clone(colIdx),
+		val:  slices.Clone(values),
+	}, nil
+}
+
+// DenseToCOO собирает ненулевые элементы плотной матрицы
+func DenseToCOO[T Scalar](m *Matrix[T]) *COO[T] {
+	coo := &COO[T]{rows: m.rows, cols: m.cols}
+	m.ForEach(func(v T, i, j int) {
+		if v != 0 {
+			coo.row = append(coo.row, i)
+			coo.col = append(coo.col, j)
+			coo.val = append(coo.val, v)
+		}
+	})
+	return coo
+}
+
+// DenseToCSR переводит плотную матрицу в формат CSR
+func DenseToCSR[T Scalar](m *Matrix[T]) *CSR[T] {
+	return DenseToCOO(m).ToCSR()
+}
+
+// DenseToCSC переводит плотную матрицу в формат CSC
+func DenseToCSC[T Scalar](m *Matrix[T]) *CSC[T] {
+	return DenseToCOO(m).ToCSC()
+}
+
+// Append добавляет элемент; при повторе позиции значения складываются при переводе в CSR/CSC
+func (m *COO[T]) Append(row, col int, value T) error {
+	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
+		return errors.New("индексы выходят за пределы матрицы")
+	}
+	m.row = append(m.row, row)
+	m.col = append(m.col, col)
+	m.val = append(m.val, value)
+	return nil
+}
+
+// Size возвращает размеры матрицы (строки, столбцы)
+func (m *COO[T]) Size() (int, int) {
+	return m.rows, m.cols
+}
+
+// NNZ возвращает число хранимых троек (включая повторы)
+func (m *COO[T]) NNZ() int {
+	return len(m.val)
+}
+
+// ForEach вызывает fn для каждой хранимой тройки в порядке добавления
+func (m *COO[T]) ForEach(fn func(T, int, int)) {
+	for k, v := range m.val {
+		fn(v, m.row[k], m.col[k])
+	}
+}
+
+// Transpose возвращает транспонированную матрицу
+func (m *COO[T]) Transpose() *COO[T] {
+	return &COO[T]{rows: m.cols, cols: m.rows, row: slices.Clone(m.col), col: slices.Clone(m.row), val: slices.Clone(m.val)}
+}
+
+// ToCSR переводит матрицу в CSR, суммируя повторы и отбрасывая нулевые суммы
+func (m *COO[T]) ToCSR() *CSR[T] {
+	indptr, indices, values := compress(m.rows, m.row, m.col, m.val)
+	return &CSR[T]{rows: m.rows, cols: m.cols, indptr: indptr, indices: indices, values: values}
+}
+
+// ToCSC переводит матрицу в CSC, суммируя повторы и отбрасывая нулевые суммы
+func (m *COO[T]) ToCSC() *CSC[T] {
+	indptr, indices, values := compress(m.cols, m.col, m.row, m.val)
+	return &CSC[T]{rows: m.rows, cols: m.cols, indptr: indptr, indices: indices, values: values}
+}
+
+// ToDense переводит матрицу в плотную, суммируя повторы
+func (m *COO[T]) ToDense() *Matrix[T] {
+	dense, _ := New[T](m.rows, m.cols)
+	for k, v := range m.val {
+		dense.data[m.row[k]*dense.stride+m.col[k]] += v
+	}
+	return dense
+}
+
+// Size возвращает размеры матрицы (строки, столбцы)
+func (m *CSR[T]) Size() (int, int) {
+	return m.rows, m.cols
+}
+
+// NNZ возвращает число хранимых ненулевых элементов
+func (m *CSR[T]) NNZ() int {
+	return len(m.values)
+}
+
+// Get возвращает элемент (row, col); отсутствующие элементы равны нулю
+func (m *CSR[T]) Get(row, col int) (T, error) {
+	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
+		return 0, errors.New("индексы выходят за пределы матрицы")
+	}
+	return lookup(m.indptr, m.indices, m.values, row, col), nil
+}
+
+// ForEach вызывает fn для каждого хранимого элемента по строкам
+func (m *CSR[T]) ForEach(fn func(T, int, int)) {
+	for i := 0; i < m.rows; i++ {
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			fn(m.values[k], i, m.indices[k])
+		}
+	}
+}
+
+// ToCOO переводит матрицу в координатный формат
+func (m *CSR[T]) ToCOO() *COO[T] {
+	coo := &COO[T]{rows: m.rows, cols: m.cols, row: make([]int, 0, m.NNZ()), col: slices.Clone(m.indices), val: slices.Clone(m.values)}
+	for i := 0; i < m.rows; i++ {
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			coo.row = append(coo.row, i)
+		}
+	}
+	return coo
+}
+
+// ToCSC переводит матрицу в формат CSC
+func (m *CSR[T]) ToCSC() *CSC[T] {
+	return m.ToCOO().ToCSC()
+}
+
+// ToDense переводит матрицу в плотную
+func (m *CSR[T]) ToDense() *Matrix[T] {
+	dense, _ := New[T](m.rows, m.cols)
+	m.ForEach(func(v T, i, j int) { dense.set(i, j, v) })
+	return dense
+}
+
+// Transpose возвращает транспонированную матрицу. Массивы CSR матрицы A - это массивы
+// CSC матрицы A^T, поэтому транспонирование сводится к одной перестановке в CSR
+func (m *CSR[T]) Transpose() *CSR[T] {
+	t := &CSC[T]{rows: m.cols, cols: m.rows, indptr: m.indptr, indices: m.indices, values: m.values}
+	return t.ToCSR()
+}
+
+// Size возвращает размеры матрицы (строки, столбцы)
+func (m *CSC[T]) Size() (int, int) {
+	return m.rows, m.cols
+}
+
+// NNZ возвращает число хранимых ненулевых элементов
+func (m *CSC[T]) NNZ() int {
+	return len(m.values)
+}
+
+// Get возвращает элемент (row, col); отсутствующие элементы равны нулю
+func (m *CSC[T]) Get(row, col int) (T, error) {
+	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
+		return 0, errors.New("индексы выходят за пределы матрицы")
+	}
+	return lookup(m.indptr, m.indices, m.values, col, row), nil
+}
+
+// ForEach вызывает fn для каждого хранимого элемента по столбцам
+func (m *CSC[T]) ForEach(fn func(T, int, int)) {
+	for j := 0; j < m.cols; j++ {
+		for k := m.indptr[j]; k < m.indptr[j+1]; k++ {
+			fn(m.values[k], m.indices[k], j)
+		}
+	}
+}
+
+// ToCOO переводит матрицу в координатный формат
+func (m *CSC[T]) ToCOO() *COO[T] {
+	coo := &COO[T]{rows: m.rows, cols: m.cols, row: slices.Clone(m.indices), col: make([]int, 0, m.NNZ()), val: slices.Clone(m.values)}
+	for j := 0; j < m.cols; j++ {
+		for k := m.indptr[j]; k < m.indptr[j+1]; k++ {
+			coo.col = append(coo.col, j)
+		}
+	}
+	return coo
+}
+
+// ToCSR переводит матрицу в формат CSR
+func (m *CSC[T]) ToCSR() *CSR[T] {
+	return m.ToCOO().ToCSR()
+}
+
+// ToDense переводит матрицу в плотную
+func (m *CSC[T]) ToDense() *Matrix[T] {
+	dense, _ := New[T](m.rows, m.cols)
+	m.ForEach(func(v T, i, j int) { dense.set(i, j, v) })
+	return dense
+}
+
+// Transpose возвращает транспонированную матрицу
+func (m *CSC[T]) Transpose() *CSC[T] {
+	t := &CSR[T]{rows: m.cols, cols: m.rows, indptr: m.indptr, indices: m.indices, values: m.values}
+	return t.ToCSC()
+}
+
+// compress сжимает тройки по major-индексу: сортирует внутри групп по minor,
+// складывает повторы и отбрасывает нули. Возвращает indptr, indices, values
+func compress[T Scalar](n int, major, minor []int, values []T) ([]int, []int, []T) {
+	// Подсчет элементов в каждой группе (сортировка подсчетом по major)
+	indptr := make([]int, n+1)
+	for _, i := range major {
+		indptr[i+1]++
+	}
+	for i := 0; i < n; i++ {
+		indptr[i+1] += indptr[i]
+	}
+
+	next := slices.Clone(indptr[:n])
+	indices := make([]int, len(values))
+	sorted := make([]T, len(values))
+	for k, i := range major {
+		indices[next[i]] = minor[k]
+		sorted[next[i]] = values[k]
+		next[i]++
+	}
+
+	// Сортировка внутри групп и слияние повторов на месте
+	out := 0
+	start := 0
+	for i := 0; i < n; i++ {
+		end := indptr[i+1]
+		group := byIndex[T]{indices[start:end], sorted[start:end]}
+		sort.Sort(group)
+		for k := start; k < end; {
+			j, sum := indices[k], sorted[k]
+			for k++; k < end && indices[k] == j; k++ {
+				sum += sorted[k]
+			}
+			if sum != 0 {
+				indices[out], sorted[out] = j, sum
+				out++
+			}
+		}
+		start = end
+		indptr[i+1] = out
+	}
+	return indptr, indices[:out:out], sorted[:out:out]
+}
+
+// byIndex сортирует пары (индекс, значение) по индексу
+type byIndex[T Scalar] struct {
+	indices []int
+	values  []T
+}
+
+func (b byIndex[T]) Len() int           { return len(b.indices) }
+func (b byIndex[T]) Less(i, j int) bool { return b.indices[i] < b.indices[j] }
+func (b byIndex[T]) Swap(i, j int) {
+	b.indices[i], b.indices[j] = b.indices[j], b.indices[i]
+	b.values[i], b.values[j] = b.values[j], b.values[i]
+}
+
+// lookup ищет элемент minor в группе major двоичным поиском
+func lookup[T Scalar](indptr, indices []int, values []T, major, minor int) T {
+	lo, hi := indptr[major], indptr[major+1]
+	k := lo + sort.SearchInts(indices[lo:hi], minor)
+	if k < hi && indices[k] == minor {
+		return values[k]
+	}
+	return 0
+}
@@ -0,0 +1,180 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"slices"
+)
+
+// MulVec вычисляет A x
+func (m *CSR[T]) MulVec(x []T) ([]T, error) {
+	if len(x) != m.cols {
+		return nil, ErrDimensionMismatch
+	}
+	y := make([]T, m.rows)
+	for i := range y {
+		var sum T
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			sum += m.values[k] * x[m.indices[k]]
+		}
+		y[i] = sum
+	}
+	return y, nil
+}
+
+// MulDense умножает разреженную матрицу на плотную: строка результата
+// собирается из строк d, выбранных ненулевыми элементами строки A
+func (m *CSR[T]) MulDense(d *Matrix[T]) (*Matrix[T], error) {
+	if m.cols != d.rows {
+		return nil, errors.New("количество столбцов первой матрицы должно совпадать с количеством строк второй матрицы")
+	}
+	result, _ := New[T](m.rows, d.cols)
+	for i := 0; i < m.rows; i++ {
+		c := result.row(i)
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			a, b := m.values[k], d.row(m.indices[k])
+			for j := range c {
+				c[j] += a * b[j]
+			}
+		}
+	}
+	return result, nil
+}
+
+// Mul перемножает разреженные матрицы алгоритмом Густавсона: строка результата
+// накапливается в плотном буфере, затраты пропорциональны числу ненулевых произведений
+func (m *CSR[T]) Mul(other *CSR[T]) (*CSR[T], error) {
+	if m.cols != other.rows {
+		return nil, errors.New("количество столбцов первой матрицы должно совпадать с количеством строк второй матрицы")
+	}
+
+	result := &CSR[T]{rows: m.rows, cols: other.cols, indptr: make([]int, m.rows+1)}
+	accumulator := make([]T, other.cols)
+	marker := make([]int, other.cols) // marker[j] = i+1, если столбец j уже встречался в строке i
+	var columns []int
+	for i := 0; i < m.rows; i++ {
+		columns = columns[:0]
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			a, row := m.values[k], m.indices[k]
+			for l := other.indptr[row]; l < other.indptr[row+1]; l++ {
+				j := other.indices[l]
+				if marker[j] != i+1 {
+					marker[j] = i + 1
+					accumulator[j] = 0
+					columns = append(columns, j)
+				}
+				accumulator[j] += a * other.values[l]
+			}
+		}
+
+		slices.Sort(columns)
+		for _, j := range columns {
+			if accumulator[j] != 0 {
+				result.indices = append(result.indices, j)
+				result.values = append(result.values, accumulator[j])
+			}
+		}
+		result.indptr[i+1] = len(result.values)
+	}
+	return result, nil
+}
+
+// MulVec вычисляет A x
+func (m *CSC[T]) MulVec(x []T) ([]T, error) {
+	if len(x) != m.cols {
+		return nil, ErrDimensionMismatch
+	}
+	y := make([]T, m.rows)
+	for j, xj := range x {
+		for k := m.indptr[j]; k < m.indptr[j+1]; k++ {
+			y[m.indices[k]] += m.values[k] * xj
+		}
+	}
+	return y, nil
+}
+
+// CGOptions настраивает метод сопряженных градиентов
+type CGOptions struct {
+	Tolerance     float64   // относительная невязка ||b - A x|| / ||b||, по умолчанию 1e-10
+	MaxIterations int       // по умолчанию 10 * размер системы
+	Initial       []float64 // начальное приближение, по умолчанию нулевой вектор
+}
+
+// ConjugateGradient решает A x = b для симметричной положительно определенной
+// разреженной матрицы методом сопряженных градиентов. Симметричность не проверяется;
+// если обнаружено направление с p^T A p <= 0, возвращается ErrNotPositiveDefinite
+func ConjugateGradient(a *CSR[float64], b []float64, opts ...CGOptions) ([]float64, error) {
+	if a.rows != a.cols {
+		return nil, ErrNotSquare
+	}
+	n := a.rows
+	if len(b) != n {
+		return nil, ErrDimensionMismatch
+	}
+
+	var o CGOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	if o.Tolerance <= 0 {
+		o.Tolerance = 1e-10
+	}
+	if o.MaxIterations <= 0 {
+		o.MaxIterations = 10 * n
+	}
+
+	x := make([]float64, n)
+	if o.Initial != nil {
+		if len(o.Initial) != n {
+			return nil, ErrDimensionMismatch
+		}
+		copy(x, o.Initial)
+	}
+
+	// r = b - A x, p = r
+	ax, _ := a.MulVec(x)
+	r := make([]float64, n)
+	for i := range r {
+		r[i] = b[i] - ax[i]
+	}
+	p := append([]float64(nil), r...)
+
+	target := o.Tolerance * math.Sqrt(dot(b, b))
+	rr := dot(r, r)
+	for iter := 0; iter < o.MaxIterations; iter++ {
+		if math.Sqrt(rr) <= target {
+			return x, nil
+		}
+
+		ap, _ := a.MulVec(p)
+		pap := dot(p, ap)
+		if pap <= 0 {
+			return nil, ErrNotPositiveDefinite
+		}
+		alpha := rr / pap
+		for i := range x {
+			x[i] += alpha * p[i]
+			r[i] -= alpha * ap[i]
+		}
+
+		next := dot(r, r)
+		beta := next / rr
+		for i := range p {
+			p[i] = r[i] + beta*p[i]
+		}
+		rr = next
+	}
+	if math.Sqrt(rr) <= target {
+		return x, nil
+	}
+	return nil, ErrNoConvergence
+}
+
+// dot возвращает скалярное произведение векторов
+func dot(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
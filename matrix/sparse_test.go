@@ -0,0 +1,183 @@
+package matrix
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSparseConversions(t *testing.T) {
+	dense, _ := NewWithValues([][]float64{
+		{1, 0, 0, 2},
+		{0, 0, 3, 0},
+		{0, 0, 0, 0},
+		{4, 5, 0, 6},
+	})
+
+	coo := DenseToCOO(dense)
+	if coo.NNZ() != 6 {
+		t.Errorf("NNZ() = %d, want 6", coo.NNZ())
+	}
+	csr, csc := coo.ToCSR(), coo.ToCSC()
+
+	for name, got := range map[string]*Matrix[float64]{
+		"COO":     coo.ToDense(),
+		"CSR":     csr.ToDense(),
+		"CSC":     csc.ToDense(),
+		"CSR→CSC": csr.ToCSC().ToDense(),
+		"CSC→CSR": csc.ToCSR().ToDense(),
+		"CSR→COO": csr.ToCOO().ToDense(),
+		"CSC→COO": csc.ToCOO().ToDense(),
+	} {
+		if !got.IsEqual(dense) {
+			t.Errorf("%s round trip = %v", name, got.data)
+		}
+	}
+
+	if v, _ := csr.Get(3, 1); v != 5 {
+		t.Errorf("CSR.Get(3, 1) = %v, want 5", v)
+	}
+	if v, _ := csc.Get(1, 2); v != 3 {
+		t.Errorf("CSC.Get(1, 2) = %v, want 3", v)
+	}
+	if v, _ := csr.Get(2, 2); v != 0 {
+		t.Errorf("CSR.Get(2, 2) = %v, want 0", v)
+	}
+	if _, err := csr.Get(4, 0); err == nil {
+		t.Errorf("expected index error")
+	}
+
+	if !csr.Transpose().ToDense().IsEqual(dense.Transpose()) {
+		t.Errorf("CSR.Transpose() mismatch")
+	}
+	if !csc.Transpose().ToDense().IsEqual(dense.Transpose()) {
+		t.Errorf("CSC.Transpose() mismatch")
+	}
+	if !coo.Transpose().ToDense().IsEqual(dense.Transpose()) {
+		t.Errorf("COO.Transpose() mismatch")
+	}
+}
+
+func TestCOODuplicates(t *testing.T) {
+	coo, err := NewCOO(2, 2, []int{0, 1, 0, 1}, []int{1, 0, 1, 0}, []int{2, 3, 5, -3})
+	if err != nil {
+		t.Fatalf("NewCOO() error = %v", err)
+	}
+	_ = coo.Append(1, 1, 4)
+
+	csr := coo.ToCSR()
+	// (0,1): 2+5, (1,0): 3-3 = 0 отбрасывается, (1,1): 4
+	if csr.NNZ() != 2 {
+		t.Errorf("NNZ() = %d, want 2", csr.NNZ())
+	}
+	if v, _ := csr.Get(0, 1); v != 7 {
+		t.Errorf("Get(0, 1) = %d, want 7", v)
+	}
+
+	if _, err := NewCOO(2, 2, []int{2}, []int{0}, []int{1}); err == nil {
+		t.Errorf("expected index error")
+	}
+	if _, err := NewCOO(2, 2, []int{0, 1}, []int{0}, []int{1}); err == nil {
+		t.Errorf("expected length error")
+	}
+	if err := coo.Append(0, 5, 1); err == nil {
+		t.Errorf("expected Append index error")
+	}
+}
+
+func randomSparse(rng *rand.Rand, rows, cols int, density float64) *Matrix[float64] {
+	m, _ := New[float64](rows, cols)
+	for i := range m.data {
+		if rng.Float64() < density {
+			m.data[i] = float64(rng.Intn(9) + 1)
+		}
+	}
+	return m
+}
+
+func TestSparseMultiply(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	a := randomSparse(rng, 40, 30, 0.1)
+	b := randomSparse(rng, 30, 20, 0.1)
+	want, _ := MultiplyScalar(a, b)
+
+	product, err := DenseToCSR(a).Mul(DenseToCSR(b))
+	if err != nil {
+		t.Fatalf("Mul() error = %v", err)
+	}
+	if !product.ToDense().IsEqual(want) {
+		t.Errorf("sparse × sparse differs from dense product")
+	}
+
+	withDense, err := DenseToCSR(a).MulDense(b)
+	if err != nil {
+		t.Fatalf("MulDense() error = %v", err)
+	}
+	if !withDense.IsEqual(want) {
+		t.Errorf("sparse × dense differs from dense product")
+	}
+
+	x := make([]float64, 30)
+	for i := range x {
+		x[i] = float64(i)
+	}
+	column, _ := NewFromData(30, 1, x)
+	expected, _ := MultiplyScalar(a, column)
+	y1, _ := DenseToCSR(a).MulVec(x)
+	y2, _ := DenseToCSC(a).MulVec(x)
+	for i := range y1 {
+		if y1[i] != expected.data[i] || y2[i] != expected.data[i] {
+			t.Fatalf("MulVec()[%d] = %v, %v; want %v", i, y1[i], y2[i], expected.data[i])
+		}
+	}
+
+	if _, err := DenseToCSR(a).Mul(DenseToCSR(a)); err == nil {
+		t.Errorf("expected dimension error")
+	}
+	if _, err := DenseToCSR(a).MulVec(x[:3]); !errors.Is(err, ErrDimensionMismatch) {
+		t.Errorf("MulVec() error = %v, want ErrDimensionMismatch", err)
+	}
+}
+
+// laplacian строит матрицу одномерного оператора Лапласа: 2 на диагонали, -1 рядом
+func laplacian(n int) *CSR[float64] {
+	coo, _ := NewCOO[float64](n, n, nil, nil, nil)
+	for i := 0; i < n; i++ {
+		_ = coo.Append(i, i, 2)
+		if i > 0 {
+			_ = coo.Append(i, i-1, -1)
+			_ = coo.Append(i-1, i, -1)
+		}
+	}
+	return coo.ToCSR()
+}
+
+func TestConjugateGradient(t *testing.T) {
+	n := 100
+	a := laplacian(n)
+	want := make([]float64, n)
+	for i := range want {
+		want[i] = math.Sin(float64(i))
+	}
+	b, _ := a.MulVec(want)
+
+	x, err := ConjugateGradient(a, b)
+	if err != nil {
+		t.Fatalf("ConjugateGradient() error = %v", err)
+	}
+	for i := range want {
+		if math.Abs(x[i]-want[i]) > 1e-6 {
+			t.Fatalf("x[%d] = %v, want %v", i, x[i], want[i])
+		}
+	}
+
+	if _, err := ConjugateGradient(a, b, CGOptions{MaxIterations: 2}); !errors.Is(err, ErrNoConvergence) {
+		t.Errorf("error = %v, want ErrNoConvergence", err)
+	}
+
+	indefinite, _ := NewWithValues([][]float64{{1, 0}, {0, -1}})
+	if _, err := ConjugateGradient(DenseToCSR(indefinite), []float64{1, 1}); !errors.Is(err, ErrNotPositiveDefinite) {
+		t.Errorf("error = %v, want ErrNotPositiveDefinite", err)
+	}
+}
@@ -0,0 +1,177 @@
+package matrix
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestViewsShareStorage(t *testing.T) {
+	m, _ := NewWithValues([][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+
+	row, err := m.Row(1)
+	if err != nil {
+		t.Fatalf("Row() error = %v", err)
+	}
+	col, _ := m.Col(2)
+	sub, _ := m.Slice(1, 3, 1, 3)
+
+	if r, c := row.Size(); r != 1 || c != 3 {
+		t.Errorf("Row size = %dx%d", r, c)
+	}
+	if r, c := col.Size(); r != 3 || c != 1 {
+		t.Errorf("Col size = %dx%d", r, c)
+	}
+	if v, _ := col.Get(2, 0); v != 9 {
+		t.Errorf("Col(2)[2] = %d, want 9", v)
+	}
+	if v, _ := sub.Get(0, 0); v != 5 {
+		t.Errorf("Slice[0][0] = %d, want 5", v)
+	}
+
+	// Запись через представление видна в исходной матрице и в других представлениях
+	_ = sub.Set(0, 1, 60)
+	if v, _ := m.Get(1, 2); v != 60 {
+		t.Errorf("m[1][2] = %d, want 60", v)
+	}
+	if v, _ := row.Get(0, 2); v != 60 {
+		t.Errorf("row[2] = %d, want 60", v)
+	}
+	if v, _ := col.Get(1, 0); v != 60 {
+		t.Errorf("col[1] = %d, want 60", v)
+	}
+
+	// Операции над представлением не выходят за его границы
+	sub.Fill(0)
+	want, _ := NewWithValues([][]int{{1, 2, 3}, {4, 0, 0}, {7, 0, 0}})
+	if !m.IsEqual(want) {
+		t.Errorf("after Fill: %v", m.data)
+	}
+
+	// Копия представления независима
+	clone := sub.Clone()
+	_ = clone.Set(0, 0, 100)
+	if v, _ := m.Get(1, 1); v != 0 {
+		t.Errorf("Clone shares storage with the matrix")
+	}
+	if len(clone.data) != 4 {
+		t.Errorf("Clone of a view should be compact, got %d elements", len(clone.data))
+	}
+
+	if transposed := sub.Transpose(); transposed.Rows() != 2 || transposed.Cols() != 2 {
+		t.Errorf("Transpose of a view has size %dx%d", transposed.Rows(), transposed.Cols())
+	}
+}
+
+func TestViewBounds(t *testing.T) {
+	m, _ := New[float64](3, 4)
+	tests := []struct {
+		name           string
+		r0, r1, c0, c1 int
+		wantErr        bool
+	}{
+		{"Whole matrix", 0, 3, 0, 4, false},
+		{"Inner block", 1, 2, 1, 3, false},
+		{"Rows out of range", 0, 4, 0, 1, true},
+		{"Negative column", 0, 1, -1, 1, true},
+		{"Empty", 1, 1, 0, 4, true},
+	}
+	for _, tt := range tests {
+		if _, err := m.Slice(tt.r0, tt.r1, tt.c0, tt.c1); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Slice() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+	if _, err := m.Row(3); err == nil {
+		t.Errorf("Row(3) should fail")
+	}
+	if _, err := m.Col(-1); err == nil {
+		t.Errorf("Col(-1) should fail")
+	}
+}
+
+func TestNewFromData(t *testing.T) {
+	data := []float64{1, 2, 3, 4, 5, 6}
+	m, err := NewFromData(2, 3, data)
+	if err != nil {
+		t.Fatalf("NewFromData() error = %v", err)
+	}
+	_ = m.Set(1, 0, 40)
+	if data[3] != 40 {
+		t.Errorf("NewFromData should not copy, data = %v", data)
+	}
+	if _, err := NewFromData(2, 2, data); err == nil {
+		t.Errorf("expected error for length mismatch")
+	}
+}
+
+func randomMatrix[T Scalar](rng *rand.Rand, rows, cols int) *Matrix[T] {
+	m, _ := New[T](rows, cols)
+	for i := range m.data {
+		m.data[i] = T(rng.Intn(19) - 9)
+	}
+	return m
+}
+
+func testMultiplyScalar[T Scalar](t *testing.T, name string) {
+	rng := rand.New(rand.NewSource(7))
+	a := randomMatrix[T](rng, 150, 130)
+	b := randomMatrix[T](rng, 130, 140)
+	want, _ := a.Multiply(b, func(x, y T) T { return x * y }, func(x, y T) T { return x + y })
+
+	for _, opts := range []MultiplyOptions{{}, {Workers: 1}, {Workers: 4, BlockSize: 16}, {BlockSize: 1000}} {
+		got, err := MultiplyScalar(a, b, opts)
+		if err != nil {
+			t.Fatalf("%s: MultiplyScalar() error = %v", name, err)
+		}
+		if !got.IsEqual(want) {
+			t.Fatalf("%s: MultiplyScalar(%+v) differs from Multiply", name, opts)
+		}
+	}
+}
+
+func TestMultiplyScalar(t *testing.T) {
+	testMultiplyScalar[float64](t, "float64")
+	testMultiplyScalar[float32](t, "float32")
+	testMultiplyScalar[int](t, "int")
+
+	// Представления в качестве множителей
+	m, _ := NewWithValues([][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+	row, _ := m.Row(0)
+	col, _ := m.Col(1)
+	dot, err := MultiplyScalar(row, col)
+	if err != nil {
+		t.Fatalf("MultiplyScalar() error = %v", err)
+	}
+	if v, _ := dot.Get(0, 0); v != 1*2+2*5+3*8 {
+		t.Errorf("row * col = %d, want 36", v)
+	}
+
+	if _, err := MultiplyScalar(m, row); err == nil {
+		t.Errorf("expected dimension error")
+	}
+	sum, _ := AddScalar(m, m)
+	if v, _ := sum.Get(2, 2); v != 18 {
+		t.Errorf("AddScalar()[2][2] = %d, want 18", v)
+	}
+}
+
+func BenchmarkMultiplyScalar(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	x := randomMatrix[float64](rng, 256, 256)
+	y := randomMatrix[float64](rng, 256, 256)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = MultiplyScalar(x, y)
+	}
+}
+
+func BenchmarkMultiplyFunc(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	x := randomMatrix[float64](rng, 256, 256)
+	y := randomMatrix[float64](rng, 256, 256)
+	mul := func(p, q float64) float64 { return p * q }
+	add := func(p, q float64) float64 { return p + q }
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = x.Multiply(y, mul, add)
+	}
+}
@@ -0,0 +1,88 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Currency описывает валюту ISO 4217
+type Currency struct {
+	// Code - буквенный код ("USD")
+	Code string
+	// Numeric - цифровой код (840)
+	Numeric int
+	// MinorUnits - количество знаков дробной части (2 для центов, 0 для иены)
+	MinorUnits int32
+}
+
+// String возвращает буквенный код валюты
+func (c Currency) String() string {
+	return c.Code
+}
+
+// ErrUnknownCurrency возвращается для кода, которого нет в справочнике
+var ErrUnknownCurrency = errors.New("неизвестная валюта")
+
+// Часто используемые валюты
+var (
+	USD = Currency{Code: "USD", Numeric: 840, MinorUnits: 2}
+	EUR = Currency{Code: "EUR", Numeric: 978, MinorUnits: 2}
+	GBP = Currency{Code: "GBP", Numeric: 826, MinorUnits: 2}
+	RUB = Currency{Code: "RUB", Numeric: 643, MinorUnits: 2}
+	CNY = Currency{Code: "CNY", Numeric: 156, MinorUnits: 2}
+	CHF = Currency{Code: "CHF", Numeric: 756, MinorUnits: 2}
+	JPY = Currency{Code: "JPY", Numeric: 392, MinorUnits: 0}
+	KWD = Currency{Code: "KWD", Numeric: 414, MinorUnits: 3}
+)
+
+var (
+	currenciesMu sync.RWMutex
+	currencies   = map[string]Currency{}
+)
+
+func init() {
+	for _, c := range []Currency{
+		USD, EUR, GBP, RUB, CNY, CHF, JPY, KWD,
+		{"AED", 784, 2}, {"ARS", 32, 2}, {"AUD", 36, 2}, {"BHD", 48, 3},
+		{"BRL", 986, 2}, {"BYN", 933, 2}, {"CAD", 124, 2}, {"CLF", 990, 4},
+		{"CLP", 152, 0}, {"CZK", 203, 2}, {"DKK", 208, 2}, {"EGP", 818, 2},
+		{"HKD", 344, 2}, {"HUF", 348, 2}, {"IDR", 360, 2}, {"ILS", 376, 2},
+		{"INR", 356, 2}, {"ISK", 352, 0}, {"JOD", 400, 3}, {"KRW", 410, 0},
+		{"KZT", 398, 2}, {"MXN", 484, 2}, {"NOK", 578, 2}, {"NZD", 554, 2},
+		{"OMR", 512, 3}, {"PLN", 985, 2}, {"SAR", 682, 2}, {"SEK", 752, 2},
+		{"SGD", 702, 2}, {"THB", 764, 2}, {"TND", 788, 3}, {"TRY", 949, 2},
+		{"UAH", 980, 2}, {"VND", 704, 0}, {"ZAR", 710, 2},
+	} {
+		currencies[c.Code] = c
+	}
+}
+
+// LookupCurrency возвращает валюту по буквенному коду (регистр не важен)
+func LookupCurrency(code string) (Currency, error) {
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+
+	c, ok := currencies[strings.ToUpper(strings.TrimSpace(code))]
+	if !ok {
+		return Currency{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+	}
+	return c, nil
+}
+
+// RegisterCurrency добавляет или заменяет валюту в справочнике
+// (например, для внутренних единиц или новых кодов ISO 4217)
+func RegisterCurrency(c Currency) error {
+	if len(c.Code) != 3 || strings.ToUpper(c.Code) != c.Code {
+		return fmt.Errorf("код валюты должен состоять из трех заглавных букв: %q", c.Code)
+	}
+	if c.MinorUnits < 0 {
+		return errors.New("количество знаков дробной части не может быть отрицательным")
+	}
+
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+	currencies[c.Code] = c
+	return nil
+}
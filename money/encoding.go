@@ -0,0 +1,76 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	bignum "types/big"
+)
+
+// jsonMoney - представление Money в JSON. Сумма передается строкой,
+// чтобы клиенты не теряли точность при разборе чисел в float64
+type jsonMoney struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// MarshalJSON реализует интерфейс json.Marshaler: {"amount":"12.50","currency":"USD"}
+func (m Money) MarshalJSON() ([]byte, error) {
+	amount := m.value()
+	if amount.Scale() < m.currency.MinorUnits {
+		amount = amount.Rescale(m.currency.MinorUnits, bignum.HalfEven)
+	}
+	return json.Marshal(jsonMoney{Amount: amount.String(), Currency: m.currency.Code})
+}
+
+// UnmarshalJSON реализует интерфейс json.Unmarshaler
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var raw jsonMoney
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	parsed, err := Parse(raw.Amount + " " + raw.Currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// MarshalText реализует интерфейс encoding.TextMarshaler в формате String
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText реализует интерфейс encoding.TextUnmarshaler
+func (m *Money) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value реализует интерфейс driver.Valuer: сумма хранится строкой "12.50 USD".
+// Для раздельных колонок NUMERIC и CHAR(3) используйте Amount() и Currency()
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan реализует интерфейс sql.Scanner
+func (m *Money) Scan(value any) error {
+	switch v := value.(type) {
+	case string:
+		return m.UnmarshalText([]byte(v))
+	case []byte:
+		return m.UnmarshalText(v)
+	default:
+		return fmt.Errorf("money: неподдерживаемый тип %T для Scan", value)
+	}
+}
@@ -0,0 +1,295 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	bignum "types/big"
+)
+
+// Ошибки операций с деньгами
+var (
+	ErrCurrencyMismatch = errors.New("валюты не совпадают")
+	ErrInvalidRatios    = errors.New("доли распределения должны быть неотрицательными и не все нулевыми")
+	ErrSubMinorAmount   = errors.New("сумма содержит доли меньше минимальной единицы валюты")
+	ErrInvalidRate      = errors.New("курс должен быть положительным")
+)
+
+// Money представляет точную денежную сумму в определенной валюте.
+// Сумма хранится без округления; к минимальным единицам валюты ее приводят
+// Round, Allocate и форматирование
+type Money struct {
+	amount   *bignum.Big
+	currency Currency
+}
+
+// New создает денежную сумму
+func New(amount *bignum.Big, currency Currency) Money {
+	return Money{amount: amount.Clone(), currency: currency}
+}
+
+// NewFromMinor создает сумму из количества минимальных единиц (центов, копеек)
+func NewFromMinor(units int64, currency Currency) Money {
+	return Money{amount: bignum.FromUnscaled(big.NewInt(units), currency.MinorUnits), currency: currency}
+}
+
+// Parse разбирает сумму в формате "12.50 USD" или "USD 12.50".
+// Разделители разрядов и десятичная запятая не принимаются
+func Parse(s string) (Money, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Money{}, fmt.Errorf("неверный формат суммы: %q", s)
+	}
+
+	amountText, code := fields[0], fields[1]
+	if _, err := LookupCurrency(amountText); err == nil {
+		amountText, code = code, amountText
+	}
+
+	currency, err := LookupCurrency(code)
+	if err != nil {
+		return Money{}, err
+	}
+	if strings.ContainsAny(amountText, "eE,_") {
+		return Money{}, fmt.Errorf("неверный формат суммы: %q", s)
+	}
+	amount, err := bignum.Parse(amountText)
+	if err != nil {
+		return Money{}, fmt.Errorf("неверный формат суммы: %q", s)
+	}
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// Amount возвращает копию суммы
+func (m Money) Amount() *bignum.Big {
+	return m.value().Clone()
+}
+
+// Currency возвращает валюту суммы
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// MinorUnits возвращает сумму в минимальных единицах валюты.
+// Второй результат ложен, если сумма не выражается целым числом единиц или не помещается в int64
+func (m Money) MinorUnits() (int64, bool) {
+	units, ok := m.minor()
+	return units.Int64(), ok && units.IsInt64()
+}
+
+// Add складывает суммы в одной валюте
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.value().Add(other.value()), currency: m.currency}, nil
+}
+
+// Subtract вычитает сумму в той же валюте
+func (m Money) Subtract(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.value().Subtract(other.value()), currency: m.currency}, nil
+}
+
+// Multiply умножает сумму на коэффициент без округления
+func (m Money) Multiply(factor *bignum.Big) Money {
+	return Money{amount: m.value().Multiply(factor), currency: m.currency}
+}
+
+// Round округляет сумму до минимальных единиц валюты
+func (m Money) Round(mode bignum.RoundingMode) Money {
+	return Money{amount: m.value().Rescale(m.currency.MinorUnits, mode), currency: m.currency}
+}
+
+// Negate возвращает сумму с противоположным знаком
+func (m Money) Negate() Money {
+	return Money{amount: m.value().Negate(), currency: m.currency}
+}
+
+// Abs возвращает абсолютное значение суммы
+func (m Money) Abs() Money {
+	return Money{amount: m.value().Abs(), currency: m.currency}
+}
+
+// Sign возвращает -1, 0 или 1
+func (m Money) Sign() int {
+	return m.value().Sign()
+}
+
+// IsZero проверяет, равна ли сумма нулю
+func (m Money) IsZero() bool {
+	return m.Sign() == 0
+}
+
+// Compare сравнивает суммы в одной валюте (-1, 0, 1)
+func (m Money) Compare(other Money) (int, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return 0, err
+	}
+	return m.value().Compare(other.value()), nil
+}
+
+// Equals проверяет, совпадают ли валюта и сумма
+func (m Money) Equals(other Money) bool {
+	return m.currency == other.currency && m.value().Compare(other.value()) == 0
+}
+
+// Allocate распределяет сумму пропорционально долям без потери минимальных единиц:
+// остаток от округления вниз раздается по одной единице частям с наибольшими остатками,
+// а при равенстве - первым по порядку. Сумма частей всегда равна исходной
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	total := new(big.Int)
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, ErrInvalidRatios
+		}
+		total.Add(total, big.NewInt(r))
+	}
+	if len(ratios) == 0 || total.Sign() == 0 {
+		return nil, ErrInvalidRatios
+	}
+
+	units, ok := m.minor()
+	if !ok {
+		return nil, ErrSubMinorAmount
+	}
+
+	// Округляем каждую долю к нулю и запоминаем остатки
+	shares := make([]*big.Int, len(ratios))
+	remainders := make([]*big.Int, len(ratios))
+	left := new(big.Int).Set(units)
+	for i, r := range ratios {
+		product := new(big.Int).Mul(units, big.NewInt(r))
+		shares[i], remainders[i] = new(big.Int).QuoRem(product, total, new(big.Int))
+		remainders[i].Abs(remainders[i])
+		left.Sub(left, shares[i])
+	}
+
+	// Раздаем оставшиеся единицы частям с наибольшими остатками
+	step := big.NewInt(int64(left.Sign()))
+	for left.Sign() != 0 {
+		best := -1
+		for i := range shares {
+			if ratios[i] != 0 && (best < 0 || remainders[i].Cmp(remainders[best]) > 0) {
+				best = i
+			}
+		}
+		shares[best].Add(shares[best], step)
+		remainders[best].SetInt64(-1)
+		left.Sub(left, step)
+	}
+
+	result := make([]Money, len(shares))
+	for i, share := range shares {
+		result[i] = Money{amount: bignum.FromUnscaled(share, m.currency.MinorUnits), currency: m.currency}
+	}
+	return result, nil
+}
+
+// Split делит сумму на n почти равных частей; части отличаются не более чем на одну минимальную единицу
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, errors.New("количество частей должно быть положительным")
+	}
+	ratios := make([]int64, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// Convert переводит сумму в другую валюту по курсу rate (единиц to за одну единицу
+// исходной валюты) и округляет результат до минимальных единиц to способом mode.
+// Для nil, нулевого или отрицательного курса возвращается ErrInvalidRate
+func (m Money) Convert(to Currency, rate *bignum.Big, mode bignum.RoundingMode) (Money, error) {
+	if err := checkRate(rate); err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.value().Multiply(rate).Rescale(to.MinorUnits, mode), currency: to}, nil
+}
+
+// checkRate проверяет, что курс задан и положителен
+func checkRate(rate *bignum.Big) error {
+	if rate == nil || rate.Sign() <= 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidRate, rate)
+	}
+	return nil
+}
+
+// Rates предоставляет курсы обмена; реализуется вызывающей стороной
+// (справочник ЦБ, кэш внешнего сервиса и т.п.)
+type Rates interface {
+	// Rate возвращает количество единиц to за одну единицу from
+	Rate(from, to Currency) (*bignum.Big, error)
+}
+
+// RateTable - простая таблица курсов в памяти, реализующая Rates.
+// Ключ - пара кодов вида "USD/EUR"
+type RateTable map[string]*bignum.Big
+
+// Rate реализует Rates. Обратный курс вычисляется, если задан только прямой.
+// Неположительный курс в таблице дает ErrInvalidRate
+func (t RateTable) Rate(from, to Currency) (*bignum.Big, error) {
+	if from == to {
+		return bignum.New(1), nil
+	}
+	if rate, ok := t[from.Code+"/"+to.Code]; ok {
+		if err := checkRate(rate); err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", from.Code, to.Code, err)
+		}
+		return rate, nil
+	}
+	if rate, ok := t[to.Code+"/"+from.Code]; ok {
+		if err := checkRate(rate); err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", to.Code, from.Code, err)
+		}
+		return bignum.New(1).Divide(rate), nil
+	}
+	return nil, fmt.Errorf("нет курса %s/%s", from.Code, to.Code)
+}
+
+// ConvertWith переводит сумму в валюту to по курсу из rates
+func (m Money) ConvertWith(rates Rates, to Currency, mode bignum.RoundingMode) (Money, error) {
+	rate, err := rates.Rate(m.currency, to)
+	if err != nil {
+		return Money{}, err
+	}
+	return m.Convert(to, rate, mode)
+}
+
+// String возвращает сумму в нейтральном формате "1234.50 USD": точка как
+// десятичный разделитель, без разделителей разрядов, не меньше MinorUnits знаков
+func (m Money) String() string {
+	amount := m.value()
+	if amount.Scale() < m.currency.MinorUnits {
+		amount = amount.Rescale(m.currency.MinorUnits, bignum.HalfEven)
+	}
+	return amount.String() + " " + m.currency.Code
+}
+
+// value возвращает сумму или 0 для нулевого значения Money{}
+func (m Money) value() *bignum.Big {
+	if m.amount == nil {
+		return bignum.New(0)
+	}
+	return m.amount
+}
+
+// minor возвращает сумму в минимальных единицах и признак того, что она целая
+func (m Money) minor() (*big.Int, bool) {
+	amount := m.value()
+	rounded := amount.Rescale(m.currency.MinorUnits, bignum.Down)
+	return rounded.Unscaled(), rounded.Compare(amount) == 0
+}
+
+// sameCurrency проверяет, что суммы в одной валюте
+func (m Money) sameCurrency(other Money) error {
+	if m.currency != other.currency {
+		return fmt.Errorf("%w: %s и %s", ErrCurrencyMismatch, m.currency.Code, other.currency.Code)
+	}
+	return nil
+}
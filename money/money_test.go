@@ -0,0 +1,173 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	bignum "types/big"
+)
+
+func mustParse(t *testing.T, s string) Money {
+	t.Helper()
+	m, err := Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q) error = %v", s, err)
+	}
+	return m
+}
+
+func TestParseAndString(t *testing.T) {
+	tests := map[string]string{
+		"12.5 USD":   "12.50 USD",
+		"USD 12.50":  "12.50 USD",
+		"-0.1 eur":   "-0.10 EUR",
+		"1000 JPY":   "1000 JPY",
+		"1.234 KWD":  "1.234 KWD",
+		"0.0001 USD": "0.0001 USD",
+	}
+	for input, want := range tests {
+		if got := mustParse(t, input).String(); got != want {
+			t.Errorf("Parse(%q).String() = %s, want %s", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"12.50", "1,000.00 USD", "12.50 XYZ", "1e3 USD", "abc USD"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) expected error", input)
+		}
+	}
+	if _, err := Parse("1 XYZ"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("expected ErrUnknownCurrency, got %v", err)
+	}
+}
+
+func TestArithmeticRefusesCurrencyMix(t *testing.T) {
+	sum, err := mustParse(t, "0.10 USD").Add(mustParse(t, "0.20 USD"))
+	if err != nil || !sum.Equals(mustParse(t, "0.30 USD")) {
+		t.Errorf("Add() = %s, %v", sum, err)
+	}
+
+	if _, err := mustParse(t, "1 USD").Add(mustParse(t, "1 EUR")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("expected ErrCurrencyMismatch, got %v", err)
+	}
+	if _, err := mustParse(t, "1 USD").Compare(mustParse(t, "1 EUR")); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("expected ErrCurrencyMismatch, got %v", err)
+	}
+
+	tax := mustParse(t, "19.99 USD").Multiply(bignum.New("0.0825"))
+	if got := tax.Round(bignum.HalfUp).String(); got != "1.65 USD" {
+		t.Errorf("Round() = %s, want 1.65 USD", got)
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	parts, err := mustParse(t, "100.00 USD").Split(3)
+	if err != nil {
+		t.Fatalf("Split() error = %v", err)
+	}
+	want := []string{"33.34 USD", "33.33 USD", "33.33 USD"}
+	for i, p := range parts {
+		if p.String() != want[i] {
+			t.Errorf("part %d = %s, want %s", i, p, want[i])
+		}
+	}
+
+	parts, _ = mustParse(t, "0.05 EUR").Allocate(3, 7)
+	if parts[0].String() != "0.02 EUR" || parts[1].String() != "0.03 EUR" {
+		t.Errorf("Allocate(3, 7) = %v", parts)
+	}
+
+	parts, _ = mustParse(t, "-10.00 USD").Split(3)
+	total := NewFromMinor(0, USD)
+	for _, p := range parts {
+		total, _ = total.Add(p)
+	}
+	if !total.Equals(mustParse(t, "-10 USD")) {
+		t.Errorf("parts sum to %s, want -10.00 USD", total)
+	}
+
+	if _, err := mustParse(t, "1.001 USD").Split(2); !errors.Is(err, ErrSubMinorAmount) {
+		t.Errorf("expected ErrSubMinorAmount, got %v", err)
+	}
+	if _, err := mustParse(t, "1 USD").Allocate(0, 0); !errors.Is(err, ErrInvalidRatios) {
+		t.Errorf("expected ErrInvalidRatios, got %v", err)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	eur, err := mustParse(t, "100 USD").Convert(EUR, bignum.New("0.9234"), bignum.HalfEven)
+	if err != nil || eur.String() != "92.34 EUR" {
+		t.Errorf("Convert() = %s, %v", eur, err)
+	}
+
+	rates := RateTable{"USD/JPY": bignum.New("151.37")}
+	jpy, err := mustParse(t, "10.50 USD").ConvertWith(rates, JPY, bignum.HalfUp)
+	if err != nil || jpy.String() != "1589 JPY" {
+		t.Errorf("ConvertWith() = %s, %v", jpy, err)
+	}
+	usd, err := mustParse(t, "1513.70 JPY").ConvertWith(rates, USD, bignum.HalfUp)
+	if err != nil || usd.String() != "10.00 USD" {
+		t.Errorf("ConvertWith() inverse = %s, %v", usd, err)
+	}
+	if _, err := mustParse(t, "1 USD").ConvertWith(rates, EUR, bignum.HalfUp); err == nil {
+		t.Errorf("expected error for missing rate")
+	}
+}
+
+func TestConvertInvalidRate(t *testing.T) {
+	price := mustParse(t, "1 USD")
+	for _, rate := range []*bignum.Big{nil, bignum.New(0), bignum.New("-0.5")} {
+		if _, err := price.Convert(EUR, rate, bignum.HalfEven); !errors.Is(err, ErrInvalidRate) {
+			t.Errorf("Convert(%v) error = %v, want ErrInvalidRate", rate, err)
+		}
+	}
+
+	// Обратный курс из нулевого прямого не должен паниковать делением на ноль
+	rates := RateTable{"EUR/USD": bignum.New(0), "USD/GBP": bignum.New(-1)}
+	for _, to := range []Currency{EUR, GBP} {
+		if _, err := price.ConvertWith(rates, to, bignum.HalfEven); !errors.Is(err, ErrInvalidRate) {
+			t.Errorf("ConvertWith(%s) error = %v, want ErrInvalidRate", to.Code, err)
+		}
+	}
+}
+
+func TestEncoding(t *testing.T) {
+	type invoice struct {
+		Total Money `json:"total"`
+	}
+
+	data, err := json.Marshal(invoice{Total: mustParse(t, "1234.5 RUB")})
+	if err != nil || string(data) != `{"total":{"amount":"1234.50","currency":"RUB"}}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+
+	var out invoice
+	if err := json.Unmarshal(data, &out); err != nil || !out.Total.Equals(mustParse(t, "1234.50 RUB")) {
+		t.Errorf("Unmarshal() = %s, %v", out.Total, err)
+	}
+
+	v, err := mustParse(t, "5 USD").Value()
+	if err != nil || v != "5.00 USD" {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+	var scanned Money
+	if err := scanned.Scan([]byte("5.00 USD")); err != nil || !scanned.Equals(NewFromMinor(500, USD)) {
+		t.Errorf("Scan() = %s, %v", scanned, err)
+	}
+}
+
+func TestMinorUnits(t *testing.T) {
+	if units, ok := mustParse(t, "12.34 USD").MinorUnits(); !ok || units != 1234 {
+		t.Errorf("MinorUnits() = %d, %v", units, ok)
+	}
+	if _, ok := mustParse(t, "12.345 USD").MinorUnits(); ok {
+		t.Errorf("MinorUnits() expected inexact")
+	}
+	if err := RegisterCurrency(Currency{Code: "XTS", Numeric: 963, MinorUnits: 4}); err != nil {
+		t.Fatalf("RegisterCurrency() error = %v", err)
+	}
+	if c, err := LookupCurrency("xts"); err != nil || c.MinorUnits != 4 {
+		t.Errorf("LookupCurrency() = %v, %v", c, err)
+	}
+}
@@ -0,0 +1,68 @@
+package numeric
+
+// Number описывает числовой тип проекта с точной или плавающей арифметикой:
+// *big.Big, *rational.Rational и complex.Complex. Операции не изменяют
+// получатель и возвращают новое значение, поэтому обобщенные алгоритмы
+// (например, matrix) работают со всеми реализациями одинаково.
+//
+// Zero и One не используют получатель: их можно вызывать на нулевом значении T
+// (в том числе на nil-указателе), чтобы получить нейтральные элементы
+type Number[T any] interface {
+	Add(other T) T
+	Subtract(other T) T
+	Multiply(other T) T
+	Divide(other T) T
+	Compare(other T) int
+	Zero() T
+	One() T
+}
+
+// Zero возвращает нейтральный элемент сложения для T
+func Zero[T Number[T]]() T {
+	var t T
+	return t.Zero()
+}
+
+// One возвращает нейтральный элемент умножения для T
+func One[T Number[T]]() T {
+	var t T
+	return t.One()
+}
+
+// IsZero сообщает, равно ли значение нулю
+func IsZero[T Number[T]](value T) bool {
+	return value.Compare(Zero[T]()) == 0
+}
+
+// Sum возвращает сумму значений (ноль для пустого списка)
+func Sum[T Number[T]](values ...T) T {
+	result := Zero[T]()
+	for _, v := range values {
+		result = result.Add(v)
+	}
+	return result
+}
+
+// Product возвращает произведение значений (единицу для пустого списка)
+func Product[T Number[T]](values ...T) T {
+	result := One[T]()
+	for _, v := range values {
+		result = result.Multiply(v)
+	}
+	return result
+}
+
+// Pow возвращает value^n для n >= 0 двоичным возведением в степень
+func Pow[T Number[T]](value T, n int) T {
+	if n < 0 {
+		panic("отрицательная степень")
+	}
+	result := One[T]()
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = result.Multiply(value)
+		}
+		value = value.Multiply(value)
+	}
+	return result
+}
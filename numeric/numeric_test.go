@@ -0,0 +1,51 @@
+package numeric
+
+import (
+	"testing"
+
+	"types/big"
+	"types/complex"
+	"types/rational"
+)
+
+// Проверка на этапе компиляции: типы проекта реализуют Number
+var (
+	_ Number[*big.Big]           = (*big.Big)(nil)
+	_ Number[*rational.Rational] = (*rational.Rational)(nil)
+	_ Number[complex.Complex]    = complex.Complex{}
+)
+
+func TestSumAndProduct(t *testing.T) {
+	r := Sum(rational.New(1, 2), rational.New(1, 3), rational.New(1, 6))
+	if !r.Equals(rational.NewFromInt(1)) {
+		t.Errorf("Expected 1, got %s", r)
+	}
+
+	b := Product(big.New("1.5"), big.New(2), big.New("0.1"))
+	if b.String() != "0.30" {
+		t.Errorf("Expected 0.30, got %s", b)
+	}
+
+	c := Sum[complex.Complex]()
+	if !c.IsZero() {
+		t.Errorf("Expected 0, got %s", c)
+	}
+}
+
+func TestPow(t *testing.T) {
+	if got := Pow(rational.New(2, 3), 3); !got.Equals(rational.New(8, 27)) {
+		t.Errorf("Expected 8/27, got %s", got)
+	}
+	if got := Pow(complex.New(0, 1), 2); got.Compare(complex.New(-1, 0)) != 0 {
+		t.Errorf("Expected -1, got %s", got)
+	}
+	if got := Pow(big.New(7), 0); got.String() != "1" {
+		t.Errorf("Expected 1, got %s", got)
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	if !IsZero(big.New("0.000")) || IsZero(rational.New(1, 5)) {
+		t.Errorf("IsZero returned wrong result")
+	}
+}
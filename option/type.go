@@ -31,6 +31,16 @@ func (o Option[T]) IsNone() bool {
 	return o.value == nil
 }
 
+// Set stores the value, turning the Option into Some.
+func (o *Option[T]) Set(value T) {
+	o.value = &value
+}
+
+// SetNone removes the value, turning the Option into None.
+func (o *Option[T]) SetNone() {
+	o.value = nil
+}
+
 // Get returns the value and a boolean indicating if it's present.
 func (o Option[T]) Get() (T, bool) {
 	if o.IsSome() {
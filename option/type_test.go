@@ -44,6 +44,20 @@ func TestNone(t *testing.T) {
 	}
 }
 
+func TestSetAndSetNone(t *testing.T) {
+	opt := None[int]()
+
+	opt.Set(7)
+	if value, ok := opt.Get(); !ok || value != 7 {
+		t.Errorf("Expected Some(7) after Set, got %v", opt)
+	}
+
+	opt.SetNone()
+	if opt.IsSome() {
+		t.Error("Expected None after SetNone")
+	}
+}
+
 func TestGetOrElse(t *testing.T) {
 	// Test with Some value
 	someOpt := Some(42)
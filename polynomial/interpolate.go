@@ -0,0 +1,80 @@
+package polynomial
+
+import (
+	"errors"
+
+	"types/numeric"
+)
+
+// Ошибки интерполяции
+var (
+	ErrNodeCount      = errors.New("количество узлов и значений должно совпадать и быть положительным")
+	ErrDuplicateNodes = errors.New("узлы интерполяции должны быть различны")
+)
+
+// Lagrange строит интерполяционный многочлен степени не выше len(xs)-1,
+// проходящий через точки (xs[i], ys[i]), по формуле Лагранжа.
+// С коэффициентами *rational.Rational результат точный
+func Lagrange[T numeric.Number[T]](xs, ys []T) (*Polynomial[T], error) {
+	if err := checkNodes(xs, ys); err != nil {
+		return nil, err
+	}
+
+	// master = (x - x0)(x - x1)...(x - xn); базисный многочлен i равен master / (x - xi),
+	// деленному на свое значение в xi
+	master := FromRoots(xs...)
+	result := New[T]()
+	for i, xi := range xs {
+		basis, _, _ := master.DivMod(New(numeric.Zero[T]().Subtract(xi), numeric.One[T]()))
+		result = result.Add(basis.Scale(ys[i].Divide(basis.Evaluate(xi))))
+	}
+	return result, nil
+}
+
+// Newton строит тот же интерполяционный многочлен через разделенные разности.
+// Узлы добавляются последовательно, поэтому метод удобнее Лагранжа при пополнении таблицы
+func Newton[T numeric.Number[T]](xs, ys []T) (*Polynomial[T], error) {
+	coeffs, err := DividedDifferences(xs, ys)
+	if err != nil {
+		return nil, err
+	}
+
+	// Вложенная форма: c0 + (x - x0)(c1 + (x - x1)(c2 + ...))
+	result := New[T]()
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		factor := New(numeric.Zero[T]().Subtract(xs[i]), numeric.One[T]())
+		result = result.Multiply(factor).Add(New(coeffs[i]))
+	}
+	return result, nil
+}
+
+// DividedDifferences возвращает коэффициенты формы Ньютона
+// f[x0], f[x0,x1], ..., f[x0,...,xn]
+func DividedDifferences[T numeric.Number[T]](xs, ys []T) ([]T, error) {
+	if err := checkNodes(xs, ys); err != nil {
+		return nil, err
+	}
+
+	table := append([]T(nil), ys...)
+	for level := 1; level < len(xs); level++ {
+		for i := len(xs) - 1; i >= level; i-- {
+			table[i] = table[i].Subtract(table[i-1]).Divide(xs[i].Subtract(xs[i-level]))
+		}
+	}
+	return table, nil
+}
+
+// checkNodes проверяет размеры и попарную различность узлов
+func checkNodes[T numeric.Number[T]](xs, ys []T) error {
+	if len(xs) == 0 || len(xs) != len(ys) {
+		return ErrNodeCount
+	}
+	for i := range xs {
+		for j := i + 1; j < len(xs); j++ {
+			if xs[i].Compare(xs[j]) == 0 {
+				return ErrDuplicateNodes
+			}
+		}
+	}
+	return nil
+}
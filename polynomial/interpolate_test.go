@@ -0,0 +1,58 @@
+package polynomial
+
+import (
+	"errors"
+	"testing"
+
+	"types/rational"
+)
+
+func TestInterpolation(t *testing.T) {
+	// Точки многочлена x^3/2 - x + 1/3
+	want := New(r(1, 3), r(-1, 1), r(0, 1), r(1, 2))
+	xs := []*rational.Rational{r(-1, 1), r(0, 1), r(1, 2), r(2, 1)}
+	ys := make([]*rational.Rational, len(xs))
+	for i, x := range xs {
+		ys[i] = want.Evaluate(x)
+	}
+
+	for name, interpolate := range map[string]func(xs, ys []*rational.Rational) (*Polynomial[*rational.Rational], error){
+		"Lagrange": Lagrange[*rational.Rational],
+		"Newton":   Newton[*rational.Rational],
+	} {
+		got, err := interpolate(xs, ys)
+		if err != nil {
+			t.Fatalf("%s() error = %v", name, err)
+		}
+		if !got.Equals(want) {
+			t.Errorf("%s() = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestDividedDifferences(t *testing.T) {
+	// f(x) = x^2 в узлах 0, 1, 3: f[x0]=0, f[x0,x1]=1, f[x0,x1,x2]=1
+	got, err := DividedDifferences(
+		[]*rational.Rational{r(0, 1), r(1, 1), r(3, 1)},
+		[]*rational.Rational{r(0, 1), r(1, 1), r(9, 1)},
+	)
+	if err != nil {
+		t.Fatalf("DividedDifferences() error = %v", err)
+	}
+	for i, want := range []*rational.Rational{r(0, 1), r(1, 1), r(1, 1)} {
+		if !got[i].Equals(want) {
+			t.Errorf("coefficient %d = %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+func TestInterpolationErrors(t *testing.T) {
+	xs := []*rational.Rational{r(1, 1), r(2, 2)}
+	ys := []*rational.Rational{r(0, 1), r(1, 1)}
+	if _, err := Lagrange(xs, ys); !errors.Is(err, ErrDuplicateNodes) {
+		t.Errorf("Lagrange() error = %v, want ErrDuplicateNodes", err)
+	}
+	if _, err := Newton(xs[:1], ys); !errors.Is(err, ErrNodeCount) {
+		t.Errorf("Newton() error = %v, want ErrNodeCount", err)
+	}
+}
@@ -0,0 +1,287 @@
+package polynomial
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"types/complex"
+	"types/fft"
+	"types/numeric"
+)
+
+// ErrZeroDivisor возвращается при делении на нулевой многочлен
+var ErrZeroDivisor = errors.New("деление на нулевой многочлен")
+
+// FFTThreshold - минимальное число коэффициентов каждого множителя, начиная с которого
+// многочлены с коэффициентами complex.Complex перемножаются через FFT.
+// Точные типы (*big.Big, *rational.Rational) всегда умножаются напрямую, чтобы не терять точность
+var FFTThreshold = 64
+
+// Polynomial представляет многочлен c0 + c1*x + ... + cn*x^n с коэффициентами
+// любого числового типа проекта. Коэффициенты хранятся от младшего к старшему,
+// старшие нули отбрасываются. Операции не изменяют многочлен и возвращают новый
+type Polynomial[T numeric.Number[T]] struct {
+	coeffs []T
+}
+
+// New создает многочлен из коэффициентов от младшего к старшему:
+// New(c0, c1, c2) = c0 + c1*x + c2*x^2
+func New[T numeric.Number[T]](coeffs ...T) *Polynomial[T] {
+	return newTrimmed(append([]T(nil), coeffs...))
+}
+
+// Monomial создает многочлен c*x^n
+func Monomial[T numeric.Number[T]](c T, n int) *Polynomial[T] {
+	if n < 0 {
+		panic("отрицательная степень")
+	}
+	coeffs := make([]T, n+1)
+	for i := range coeffs {
+		coeffs[i] = numeric.Zero[T]()
+	}
+	coeffs[n] = c
+	return newTrimmed(coeffs)
+}
+
+// FromRoots создает приведенный многочлен (x - r1)(x - r2)...(x - rn)
+func FromRoots[T numeric.Number[T]](roots ...T) *Polynomial[T] {
+	result := New(numeric.One[T]())
+	for _, r := range roots {
+		result = result.Multiply(New(numeric.Zero[T]().Subtract(r), numeric.One[T]()))
+	}
+	return result
+}
+
+// newTrimmed оборачивает срез коэффициентов, отбрасывая старшие нули
+func newTrimmed[T numeric.Number[T]](coeffs []T) *Polynomial[T] {
+	n := len(coeffs)
+	for n > 0 && numeric.IsZero(coeffs[n-1]) {
+		n--
+	}
+	return &Polynomial[T]{coeffs: coeffs[:n]}
+}
+
+// Degree возвращает степень многочлена; для нулевого многочлена -1
+func (p *Polynomial[T]) Degree() int {
+	return len(p.coeffs) - 1
+}
+
+// IsZero проверяет, является ли многочлен нулевым
+func (p *Polynomial[T]) IsZero() bool {
+	return len(p.coeffs) == 0
+}
+
+// Coefficient возвращает коэффициент при x^i (ноль, если i больше степени)
+func (p *Polynomial[T]) Coefficient(i int) T {
+	if i < 0 || i >= len(p.coeffs) {
+		return numeric.Zero[T]()
+	}
+	return p.coeffs[i]
+}
+
+// Coefficients возвращает копию коэффициентов от младшего к старшему
+func (p *Polynomial[T]) Coefficients() []T {
+	return append([]T(nil), p.coeffs...)
+}
+
+// Leading возвращает старший коэффициент (ноль для нулевого многочлена)
+func (p *Polynomial[T]) Leading() T {
+	return p.Coefficient(p.Degree())
+}
+
+// Evaluate вычисляет значение многочлена в точке x по схеме Горнера
+func (p *Polynomial[T]) Evaluate(x T) T {
+	result := numeric.Zero[T]()
+	for i := len(p.coeffs) - 1; i >= 0; i-- {
+		result = result.Multiply(x).Add(p.coeffs[i])
+	}
+	return result
+}
+
+// Add складывает многочлены
+func (p *Polynomial[T]) Add(other *Polynomial[T]) *Polynomial[T] {
+	n := max(len(p.coeffs), len(other.coeffs))
+	coeffs := make([]T, n)
+	for i := range coeffs {
+		coeffs[i] = p.Coefficient(i).Add(other.Coefficient(i))
+	}
+	return newTrimmed(coeffs)
+}
+
+// Subtract вычитает многочлен
+func (p *Polynomial[T]) Subtract(other *Polynomial[T]) *Polynomial[T] {
+	n := max(len(p.coeffs), len(other.coeffs))
+	coeffs := make([]T, n)
+	for i := range coeffs {
+		coeffs[i] = p.Coefficient(i).Subtract(other.Coefficient(i))
+	}
+	return newTrimmed(coeffs)
+}
+
+// Negate возвращает многочлен с противоположными коэффициентами
+func (p *Polynomial[T]) Negate() *Polynomial[T] {
+	return New[T]().Subtract(p)
+}
+
+// Scale умножает все коэффициенты на c
+func (p *Polynomial[T]) Scale(c T) *Polynomial[T] {
+	coeffs := make([]T, len(p.coeffs))
+	for i, v := range p.coeffs {
+		coeffs[i] = v.Multiply(c)
+	}
+	return newTrimmed(coeffs)
+}
+
+// Multiply перемножает многочлены. Для коэффициентов complex.Complex и степеней
+// от FFTThreshold используется быстрое преобразование Фурье, иначе - умножение столбиком
+func (p *Polynomial[T]) Multiply(other *Polynomial[T]) *Polynomial[T] {
+	if p.IsZero() || other.IsZero() {
+		return New[T]()
+	}
+	if min(len(p.coeffs), len(other.coeffs)) >= FFTThreshold {
+		if a, ok := any(p.coeffs).([]complex.Complex); ok {
+			b := any(other.coeffs).([]complex.Complex)
+			return newTrimmed(any(fft.Convolve(a, b)).([]T))
+		}
+	}
+
+	coeffs := make([]T, len(p.coeffs)+len(other.coeffs)-1)
+	for i := range coeffs {
+		coeffs[i] = numeric.Zero[T]()
+	}
+	for i, a := range p.coeffs {
+		for j, b := range other.coeffs {
+			coeffs[i+j] = coeffs[i+j].Add(a.Multiply(b))
+		}
+	}
+	return newTrimmed(coeffs)
+}
+
+// DivMod делит многочлен на divisor с остатком: p = q*divisor + r, deg r < deg divisor
+func (p *Polynomial[T]) DivMod(divisor *Polynomial[T]) (q, r *Polynomial[T], err error) {
+	if divisor.IsZero() {
+		return nil, nil, ErrZeroDivisor
+	}
+
+	rem := p.Coefficients()
+	n := len(divisor.coeffs)
+	if len(rem) < n {
+		return New[T](), newTrimmed(rem), nil
+	}
+
+	lead := divisor.Leading()
+	quot := make([]T, len(rem)-n+1)
+	for k := len(quot) - 1; k >= 0; k-- {
+		c := rem[k+n-1].Divide(lead)
+		quot[k] = c
+		for j := 0; j < n; j++ {
+			rem[k+j] = rem[k+j].Subtract(c.Multiply(divisor.coeffs[j]))
+		}
+		// Старший коэффициент остатка обнуляется точно и для приближенных типов
+		rem = rem[:k+n-1]
+	}
+	return newTrimmed(quot), newTrimmed(rem), nil
+}
+
+// Derivative возвращает производную многочлена
+func (p *Polynomial[T]) Derivative() *Polynomial[T] {
+	if len(p.coeffs) <= 1 {
+		return New[T]()
+	}
+	coeffs := make([]T, len(p.coeffs)-1)
+	for i := range coeffs {
+		coeffs[i] = p.coeffs[i+1].Multiply(fromInt[T](i + 1))
+	}
+	return newTrimmed(coeffs)
+}
+
+// Integral возвращает первообразную с нулевым свободным членом
+func (p *Polynomial[T]) Integral() *Polynomial[T] {
+	coeffs := make([]T, len(p.coeffs)+1)
+	coeffs[0] = numeric.Zero[T]()
+	for i, c := range p.coeffs {
+		coeffs[i+1] = c.Divide(fromInt[T](i + 1))
+	}
+	return newTrimmed(coeffs)
+}
+
+// Compose возвращает композицию p(q(x))
+func (p *Polynomial[T]) Compose(q *Polynomial[T]) *Polynomial[T] {
+	result := New[T]()
+	for i := len(p.coeffs) - 1; i >= 0; i-- {
+		result = result.Multiply(q).Add(New(p.coeffs[i]))
+	}
+	return result
+}
+
+// Equals проверяет точное равенство коэффициентов
+func (p *Polynomial[T]) Equals(other *Polynomial[T]) bool {
+	if len(p.coeffs) != len(other.coeffs) {
+		return false
+	}
+	for i := range p.coeffs {
+		if p.coeffs[i].Compare(other.coeffs[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// String возвращает запись от старшей степени к младшей, например "3x^2 - x + 1/2".
+// Составные коэффициенты (комплексные, дроби при старших степенях) заключаются в скобки
+func (p *Polynomial[T]) String() string {
+	if p.IsZero() {
+		return "0"
+	}
+
+	var sb strings.Builder
+	for i := len(p.coeffs) - 1; i >= 0; i-- {
+		c := p.coeffs[i]
+		if numeric.IsZero(c) {
+			continue
+		}
+
+		text := fmt.Sprint(c)
+		negative := strings.HasPrefix(text, "-") && !strings.ContainsAny(text[1:], "+-")
+		if negative {
+			text = text[1:]
+		}
+		if i > 0 && strings.ContainsAny(text, "+-/i ") {
+			text = "(" + text + ")"
+		}
+		if i > 0 && text == "1" {
+			text = ""
+		}
+
+		switch {
+		case sb.Len() == 0 && negative:
+			sb.WriteString("-")
+		case sb.Len() > 0 && negative:
+			sb.WriteString(" - ")
+		case sb.Len() > 0:
+			sb.WriteString(" + ")
+		}
+		sb.WriteString(text)
+
+		switch {
+		case i == 1:
+			sb.WriteString("x")
+		case i > 1:
+			fmt.Fprintf(&sb, "x^%d", i)
+		}
+	}
+	return sb.String()
+}
+
+// fromInt возвращает n как значение типа T, складывая единицы удвоением
+func fromInt[T numeric.Number[T]](n int) T {
+	result, unit := numeric.Zero[T](), numeric.One[T]()
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = result.Add(unit)
+		}
+		unit = unit.Add(unit)
+	}
+	return result
+}
@@ -0,0 +1,147 @@
+package polynomial
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	bignum "types/big"
+	"types/complex"
+	"types/rational"
+)
+
+// r - короткая запись рациональных коэффициентов
+func r(num, den int64) *rational.Rational {
+	return rational.New(num, den)
+}
+
+// ints создает многочлен с целыми рациональными коэффициентами
+func ints(coeffs ...int64) *Polynomial[*rational.Rational] {
+	values := make([]*rational.Rational, len(coeffs))
+	for i, c := range coeffs {
+		values[i] = rational.NewFromInt(c)
+	}
+	return New(values...)
+}
+
+func TestNewTrimsZeros(t *testing.T) {
+	p := ints(1, 2, 0, 0)
+	if p.Degree() != 1 {
+		t.Errorf("Degree() = %d, want 1", p.Degree())
+	}
+	if z := ints(0, 0); !z.IsZero() || z.Degree() != -1 {
+		t.Errorf("zero polynomial: IsZero=%v Degree=%d", z.IsZero(), z.Degree())
+	}
+	if c := p.Coefficient(5); !c.IsZero() {
+		t.Errorf("Coefficient(5) = %v, want 0", c)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	p := ints(1, 2, 3) // 3x^2 + 2x + 1
+	q := ints(-1, 1)   // x - 1
+
+	tests := []struct {
+		name string
+		got  *Polynomial[*rational.Rational]
+		want *Polynomial[*rational.Rational]
+	}{
+		{"Add", p.Add(q), ints(0, 3, 3)},
+		{"Subtract", p.Subtract(p), ints()},
+		{"Multiply", p.Multiply(q), ints(-1, -1, -1, 3)},
+		{"Scale", p.Scale(r(1, 2)), New(r(1, 2), r(1, 1), r(3, 2))},
+		{"Negate", q.Negate(), ints(1, -1)},
+		{"Derivative", p.Derivative(), ints(2, 6)},
+		{"Integral", p.Integral(), ints(0, 1, 1, 1)},
+		{"Compose", p.Compose(q), ints(2, -4, 3)},
+		{"FromRoots", FromRoots(r(1, 1), r(-2, 1)), ints(-2, 1, 1)},
+		{"Monomial", Monomial(r(5, 1), 3), ints(0, 0, 0, 5)},
+	}
+	for _, tt := range tests {
+		if !tt.got.Equals(tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if got := p.Evaluate(r(1, 2)); !got.Equals(r(11, 4)) {
+		t.Errorf("Evaluate(1/2) = %v, want 11/4", got)
+	}
+}
+
+func TestDivMod(t *testing.T) {
+	p := ints(-4, 0, -2, 1) // x^3 - 2x^2 - 4
+	d := ints(-3, 1)        // x - 3
+
+	q, rem, err := p.DivMod(d)
+	if err != nil {
+		t.Fatalf("DivMod() error = %v", err)
+	}
+	if !q.Equals(ints(3, 1, 1)) || !rem.Equals(ints(5)) {
+		t.Errorf("DivMod() = %v, %v; want x^2 + x + 3, 5", q, rem)
+	}
+
+	q, rem, _ = d.DivMod(p)
+	if !q.IsZero() || !rem.Equals(d) {
+		t.Errorf("DivMod() of lower degree = %v, %v", q, rem)
+	}
+
+	if _, _, err := p.DivMod(ints()); !errors.Is(err, ErrZeroDivisor) {
+		t.Errorf("DivMod(0) error = %v, want ErrZeroDivisor", err)
+	}
+}
+
+func TestBigCoefficients(t *testing.T) {
+	p := New(bignum.New("0.5"), bignum.New("1.25")) // 1.25x + 0.5
+	if got := p.Evaluate(bignum.New(2)); got.Compare(bignum.New(3)) != 0 {
+		t.Errorf("Evaluate(2) = %v, want 3", got)
+	}
+	if got := p.Derivative(); !got.Equals(New(bignum.New("1.25"))) {
+		t.Errorf("Derivative() = %v", got)
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		p    interface{ String() string }
+		want string
+	}{
+		{ints(), "0"},
+		{ints(1, -1, 3), "3x^2 - x + 1"},
+		{ints(0, 1), "x"},
+		{ints(-2, 0, -1), "-x^2 - 2"},
+		{New(r(1, 2), r(-3, 4)), "-(3/4)x + 1/2"},
+		{New(complex.New(1, 0), complex.New(0, 1)), "(0.000000+1.000000i)x + 1.000000+0.000000i"},
+	}
+	for _, tt := range tests {
+		if got := tt.p.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestMultiplyFFT(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	random := func(n int) []complex.Complex {
+		c := make([]complex.Complex, n)
+		for i := range c {
+			c[i] = complex.New(rng.Float64(), rng.Float64())
+		}
+		return c
+	}
+	a, b := New(random(100)...), New(random(150)...)
+
+	saved := FFTThreshold
+	FFTThreshold = 1 << 30
+	direct := a.Multiply(b)
+	FFTThreshold = saved
+	fast := a.Multiply(b)
+
+	if fast.Degree() != direct.Degree() {
+		t.Fatalf("degree %d, want %d", fast.Degree(), direct.Degree())
+	}
+	for i := 0; i <= direct.Degree(); i++ {
+		if !fast.Coefficient(i).Equals(direct.Coefficient(i), 1e-9) {
+			t.Fatalf("coefficient %d = %v, want %v", i, fast.Coefficient(i), direct.Coefficient(i))
+		}
+	}
+}
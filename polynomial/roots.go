@@ -0,0 +1,112 @@
+package polynomial
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+
+	bignum "types/big"
+	"types/complex"
+	"types/rational"
+)
+
+// Ошибки поиска корней
+var (
+	ErrZeroPolynomial  = errors.New("нулевой многочлен не имеет конечного набора корней")
+	ErrNoConvergence   = errors.New("итерации поиска корней не сошлись")
+	ErrUnsupportedType = errors.New("тип коэффициентов не приводится к complex128")
+)
+
+// Method - метод одновременного поиска всех корней
+type Method int
+
+const (
+	// Aberth - метод Аберта-Эрлиха, кубическая сходимость для простых корней
+	Aberth Method = iota
+	// DurandKerner - метод Вейерштрасса (Дюран-Кернер), квадратичная сходимость
+	DurandKerner
+)
+
+// RootOptions настраивает поиск корней
+type RootOptions struct {
+	Method        Method
+	Tolerance     float64 // относительная точность шага, по умолчанию 1e-12
+	MaxIterations int     // по умолчанию 500
+}
+
+// rootOptionsOf возвращает первый набор настроек с подставленными значениями по умолчанию
+func rootOptionsOf(opts []RootOptions) RootOptions {
+	var o RootOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	if o.Tolerance <= 0 {
+		o.Tolerance = 1e-12
+	}
+	if o.MaxIterations <= 0 {
+		o.MaxIterations = 500
+	}
+	return o
+}
+
+// Roots находит все комплексные корни многочлена с учетом кратности.
+// Коэффициенты приводятся к complex128, поэтому корни приближенные даже для
+// *rational.Rational. Части корня, меньшие Tolerance относительно его модуля, обнуляются;
+// результат упорядочен по вещественной, затем по мнимой части.
+// Если итерации не сошлись, возвращаются последние приближения вместе с ErrNoConvergence
+func (p *Polynomial[T]) Roots(opts ...RootOptions) ([]complex.Complex, error) {
+	if p.IsZero() {
+		return nil, ErrZeroPolynomial
+	}
+
+	coeffs := make([]complex128, len(p.coeffs))
+	for i, c := range p.coeffs {
+		v, ok := toComplex128(c)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", ErrUnsupportedType, c)
+		}
+		coeffs[i] = v
+	}
+
+	o := rootOptionsOf(opts)
+
+	// Нулевые корни отделяем сразу: они точные и не мешают итерациям
+	zeros := 0
+	for zeros < len(coeffs)-1 && coeffs[zeros] == 0 {
+		zeros++
+	}
+	roots, err := findRoots(coeffs[zeros:], o)
+
+	result := make([]complex.Complex, zeros, zeros+len(roots))
+	for _, z := range roots {
+		// Шум округления в частях корня убираем, чтобы вещественные корни были вещественными
+		root := complex.FromComplex128(z)
+		noise := o.Tolerance * max(1, root.Abs())
+		if math.Abs(root.Real) <= noise {
+			root.Real = 0
+		}
+		if math.Abs(root.Imag) <= noise {
+			root.Imag = 0
+		}
+		result = append(result, root)
+	}
+	slices.SortFunc(result, complex.Complex.Compare)
+	return result, err
+}
+
+// toComplex128 приводит коэффициент числового типа проекта к complex128
+func toComplex128(v any) (complex128, bool) {
+	switch x := v.(type) {
+	case complex.Complex:
+		return x.Complex128(), true
+	case *rational.Rational:
+		f, _ := new(big.Rat).SetFrac(x.Numerator(), x.Denominator()).Float64()
+		return complex.New(f, 0).Complex128(), true
+	case *bignum.Big:
+		f, _ := x.ToFloat64()
+		return complex.New(f, 0).Complex128(), true
+	}
+	return 0, false
+}
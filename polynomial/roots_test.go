@@ -0,0 +1,102 @@
+package polynomial
+
+import (
+	"errors"
+	"testing"
+
+	bignum "types/big"
+	"types/complex"
+)
+
+func assertRoots(t *testing.T, got []complex.Complex, want []complex.Complex, tolerance float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d roots %v, want %v", len(got), got, want)
+	}
+	for i := range want {
+		if !got[i].Equals(want[i], tolerance) {
+			t.Fatalf("root %d = %v, want %v (all: %v)", i, got[i], want[i], got)
+		}
+	}
+}
+
+func TestRoots(t *testing.T) {
+	real := func(values ...float64) []complex.Complex {
+		result := make([]complex.Complex, len(values))
+		for i, v := range values {
+			result[i] = complex.New(v, 0)
+		}
+		return result
+	}
+
+	for _, method := range []Method{Aberth, DurandKerner} {
+		opts := RootOptions{Method: method}
+
+		roots, err := ints(-6, 11, -6, 1).Roots(opts) // (x-1)(x-2)(x-3)
+		if err != nil {
+			t.Fatalf("Roots() error = %v", err)
+		}
+		assertRoots(t, roots, real(1, 2, 3), 1e-10)
+
+		roots, err = ints(1, 0, 1).Roots(opts) // x^2 + 1
+		if err != nil {
+			t.Fatalf("Roots() error = %v", err)
+		}
+		assertRoots(t, roots, []complex.Complex{complex.New(0, -1), complex.New(0, 1)}, 1e-10)
+
+		roots, err = ints(0, -1, 0, 1).Roots(opts) // x^3 - x
+		if err != nil {
+			t.Fatalf("Roots() error = %v", err)
+		}
+		assertRoots(t, roots, real(-1, 0, 1), 1e-10)
+	}
+}
+
+func TestRootsMultiple(t *testing.T) {
+	// (x - 1)^3: кратный корень находится с точностью около eps^(1/3)
+	roots, err := ints(-1, 3, -3, 1).Roots()
+	if err != nil {
+		t.Fatalf("Roots() error = %v", err)
+	}
+	for _, z := range roots {
+		if !z.Equals(complex.New(1, 0), 1e-4) {
+			t.Errorf("root %v, want 1", z)
+		}
+	}
+}
+
+func TestRootsWilkinson(t *testing.T) {
+	roots := make([]*bignum.Big, 10)
+	want := make([]complex.Complex, 10)
+	for i := range roots {
+		roots[i] = bignum.New(i + 1)
+		want[i] = complex.New(float64(i+1), 0)
+	}
+
+	got, err := FromRoots(roots...).Roots()
+	if err != nil {
+		t.Fatalf("Roots() error = %v", err)
+	}
+	assertRoots(t, got, want, 1e-6)
+}
+
+func TestRootsComplexCoefficients(t *testing.T) {
+	a, b := complex.New(1, 2), complex.New(-3, 0.5)
+	got, err := FromRoots(a, b).Roots()
+	if err != nil {
+		t.Fatalf("Roots() error = %v", err)
+	}
+	assertRoots(t, got, []complex.Complex{b, a}, 1e-10)
+}
+
+func TestRootsErrors(t *testing.T) {
+	if _, err := ints().Roots(); !errors.Is(err, ErrZeroPolynomial) {
+		t.Errorf("Roots() of zero error = %v", err)
+	}
+	if roots, err := ints(5).Roots(); err != nil || len(roots) != 0 {
+		t.Errorf("Roots() of constant = %v, %v", roots, err)
+	}
+	if _, err := ints(-6, 11, -6, 1).Roots(RootOptions{MaxIterations: 1}); !errors.Is(err, ErrNoConvergence) {
+		t.Errorf("Roots() with one iteration error = %v, want ErrNoConvergence", err)
+	}
+}
@@ -0,0 +1,99 @@
+package polynomial
+
+import (
+	"math"
+	"math/cmplx"
+	"slices"
+)
+
+// Вычисления ведутся во встроенном complex128; файл не импортирует types/complex,
+// чтобы не скрывать встроенную функцию complex
+
+// findRoots ищет корни многочлена с ненулевым свободным членом
+func findRoots(coeffs []complex128, o RootOptions) ([]complex128, error) {
+	n := len(coeffs) - 1
+	if n == 0 {
+		return nil, nil
+	}
+
+	// Приводим к единичному старшему коэффициенту
+	monic := make([]complex128, len(coeffs))
+	for i, c := range coeffs {
+		monic[i] = c / coeffs[n]
+	}
+
+	// Начальные приближения на окружности радиуса оценки модуля корней со сдвигом угла,
+	// чтобы не попасть на симметричные корни
+	radius := 0.0
+	for k := 1; k <= n; k++ {
+		// Оценка Фудзивары: все корни лежат в круге 2*max|c_{n-k}|^(1/k)
+		radius = max(radius, 2*math.Pow(cmplx.Abs(monic[n-k]), 1/float64(k)))
+	}
+	roots := make([]complex128, n)
+	for k := range roots {
+		roots[k] = cmplx.Rect(radius, 2*math.Pi*float64(k)/float64(n)+0.4)
+	}
+
+	done := make([]bool, n)
+	for iter := 0; iter < o.MaxIterations; iter++ {
+		for i, z := range roots {
+			if done[i] {
+				continue
+			}
+
+			value, derivative, bound := evaluate(monic, z)
+			// Невязка на уровне ошибки округления: точнее корень не уточнить
+			if cmplx.Abs(value) <= 4*float64(n)*epsilon*bound {
+				done[i] = true
+				continue
+			}
+
+			var step complex128
+			switch o.Method {
+			case DurandKerner:
+				denominator := complex(1, 0)
+				for j, w := range roots {
+					if j != i {
+						denominator *= z - w
+					}
+				}
+				step = value / denominator
+			default:
+				ratio := value / derivative
+				var sum complex128
+				for j, w := range roots {
+					if j != i {
+						sum += 1 / (z - w)
+					}
+				}
+				step = ratio / (1 - ratio*sum)
+			}
+
+			if cmplx.IsNaN(step) || cmplx.IsInf(step) {
+				// Совпавшие приближения или нулевая производная: слегка сдвигаем точку
+				step = complex(o.Tolerance*max(1, cmplx.Abs(z)), 0) * cmplx.Rect(1, float64(i))
+			}
+			roots[i] = z - step
+			done[i] = cmplx.Abs(step) <= o.Tolerance*max(1, cmplx.Abs(roots[i]))
+		}
+		if !slices.Contains(done, false) {
+			return roots, nil
+		}
+	}
+	return roots, ErrNoConvergence
+}
+
+// epsilon - машинная точность float64
+const epsilon = 0x1p-52
+
+// evaluate вычисляет значение многочлена, его производную и оценку
+// Σ|c_k||z|^k, задающую масштаб ошибки округления
+func evaluate(coeffs []complex128, z complex128) (value, derivative complex128, bound float64) {
+	abs := cmplx.Abs(z)
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		derivative = derivative*z + value
+		value = value*z + coeffs[i]
+		bound = bound*abs + cmplx.Abs(coeffs[i])
+	}
+	return value, derivative, bound
+}
@@ -0,0 +1,138 @@
+package rational
+
+import (
+	"math"
+	"math/big"
+
+	bignum "types/big"
+)
+
+// ContinuedFraction returns the finite continued fraction expansion [a0; a1, a2, ...]
+// of the number. a0 is the floor of the number and may be negative, the other
+// terms are positive. The last term is greater than 1 unless the expansion is [1] or [a0]
+func (r *Rational) ContinuedFraction() []*big.Int {
+	num := new(big.Int).Set(r.num)
+	den := new(big.Int).Set(r.den)
+
+	var terms []*big.Int
+	for den.Sign() != 0 {
+		// Деление с округлением вниз (Euclidean для положительного знаменателя)
+		q, m := new(big.Int).DivMod(num, den, new(big.Int))
+		terms = append(terms, q)
+		num, den = den, m
+	}
+	return terms
+}
+
+// FromContinuedFraction reconstructs a rational number from the terms [a0; a1, a2, ...]
+func FromContinuedFraction(terms []*big.Int) *Rational {
+	if len(terms) == 0 {
+		panic("цепная дробь не может быть пустой")
+	}
+
+	// Вычисляем с конца: x = a_i + 1/x
+	num := new(big.Int).Set(terms[len(terms)-1])
+	den := big.NewInt(1)
+	for i := len(terms) - 2; i >= 0; i-- {
+		num, den = new(big.Int).Add(new(big.Int).Mul(terms[i], num), den), num
+	}
+	return NewFromBigInt(num, den)
+}
+
+// Convergents returns the successive convergents of the continued fraction
+// expansion; the last one equals the number itself
+func (r *Rational) Convergents() []*Rational {
+	terms := r.ContinuedFraction()
+	result := make([]*Rational, len(terms))
+
+	// h_n = a_n h_{n-1} + h_{n-2}, k_n = a_n k_{n-1} + k_{n-2}
+	h1, h2 := big.NewInt(1), big.NewInt(0)
+	k1, k2 := big.NewInt(0), big.NewInt(1)
+	for i, a := range terms {
+		h := new(big.Int).Add(new(big.Int).Mul(a, h1), h2)
+		k := new(big.Int).Add(new(big.Int).Mul(a, k1), k2)
+		result[i] = NewFromBigInt(h, k)
+		h1, h2 = h, h1
+		k1, k2 = k, k1
+	}
+	return result
+}
+
+// Approximate returns the closest fraction to x with denominator at most maxDenominator.
+// x is taken exactly from its binary value, so Approximate(0.1, 10) is 1/10
+func Approximate(x float64, maxDenominator int64) *Rational {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		panic("NaN и бесконечность не могут быть представлены дробью")
+	}
+	exact := new(big.Rat).SetFloat64(x)
+	return NewFromBigInt(exact.Num(), exact.Denom()).LimitDenominator(maxDenominator)
+}
+
+// LimitDenominator returns the closest fraction to r with denominator at most maxDenominator.
+// Candidates are the last convergent and the best semiconvergent within the limit;
+// on a tie the one with the smaller denominator is returned
+func (r *Rational) LimitDenominator(maxDenominator int64) *Rational {
+	if maxDenominator < 1 {
+		panic("максимальный знаменатель должен быть положительным")
+	}
+	limit := big.NewInt(maxDenominator)
+	if r.den.Cmp(limit) <= 0 {
+		return r.Clone()
+	}
+
+	// Проходим подходящие дроби p0/q0 -> p1/q1, пока знаменатель не превысит предел
+	p0, q0, p1, q1 := big.NewInt(0), big.NewInt(1), big.NewInt(1), big.NewInt(0)
+	num, den := new(big.Int).Set(r.num), new(big.Int).Set(r.den)
+	for {
+		a, m := new(big.Int).DivMod(num, den, new(big.Int))
+		q2 := new(big.Int).Add(q0, new(big.Int).Mul(a, q1))
+		if q2.Cmp(limit) > 0 {
+			break
+		}
+		p0, q0, p1, q1 = p1, q1, new(big.Int).Add(p0, new(big.Int).Mul(a, p1)), q2
+		num, den = den, m
+	}
+
+	// Лучшая промежуточная дробь: (p0 + k p1) / (q0 + k q1) с наибольшим допустимым k
+	k := new(big.Int).Sub(limit, q0)
+	k.Quo(k, q1)
+	semi := NewFromBigInt(new(big.Int).Add(p0, new(big.Int).Mul(k, p1)), new(big.Int).Add(q0, new(big.Int).Mul(k, q1)))
+	convergent := NewFromBigInt(p1, q1)
+
+	if convergent.Subtract(r).Abs().Compare(semi.Subtract(r).Abs()) <= 0 {
+		return convergent
+	}
+	return semi
+}
+
+// Floor returns the greatest integer less than or equal to r
+func (r *Rational) Floor() *Rational {
+	return r.Round(bignum.Floor)
+}
+
+// Ceil returns the least integer greater than or equal to r
+func (r *Rational) Ceil() *Rational {
+	return r.Round(bignum.Ceiling)
+}
+
+// Round rounds r to an integer using the given rounding mode
+// (bignum.HalfEven, bignum.HalfUp, bignum.Down, ...)
+func (r *Rational) Round(mode bignum.RoundingMode) *Rational {
+	rounded := bignum.FromRat(r.rat(), 0, mode)
+	return &Rational{num: rounded.Unscaled(), den: big.NewInt(1)}
+}
+
+// Mod returns r - other*floor(r/other). The result has the sign of other,
+// so for a positive period it is always in [0, other)
+func (r *Rational) Mod(other *Rational) *Rational {
+	if other.IsZero() {
+		panic("деление на ноль")
+	}
+	return r.Subtract(other.Multiply(r.Divide(other).Floor()))
+}
+
+// Mediant returns (a+c)/(b+d) for r = a/b and other = c/d in lowest terms.
+// The mediant lies strictly between two different fractions
+func (r *Rational) Mediant(other *Rational) *Rational {
+	return NewFromBigInt(new(big.Int).Add(r.num, other.num), new(big.Int).Add(r.den, other.den))
+}
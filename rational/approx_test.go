@@ -0,0 +1,134 @@
+package rational
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	bignum "types/big"
+)
+
+func TestContinuedFraction(t *testing.T) {
+	tests := []struct {
+		value *Rational
+		want  []int64
+	}{
+		{New(415, 93), []int64{4, 2, 6, 7}},
+		{New(-7, 3), []int64{-3, 1, 2}},
+		{NewFromInt(5), []int64{5}},
+		{New(1, 2), []int64{0, 2}},
+	}
+	for _, tt := range tests {
+		terms := tt.value.ContinuedFraction()
+		if len(terms) != len(tt.want) {
+			t.Errorf("ContinuedFraction(%s) = %v, want %v", tt.value, terms, tt.want)
+			continue
+		}
+		for i := range terms {
+			if terms[i].Int64() != tt.want[i] {
+				t.Errorf("ContinuedFraction(%s) = %v, want %v", tt.value, terms, tt.want)
+				break
+			}
+		}
+		if back := FromContinuedFraction(terms); !back.Equals(tt.value) {
+			t.Errorf("FromContinuedFraction(%v) = %s, want %s", terms, back, tt.value)
+		}
+	}
+}
+
+func TestConvergents(t *testing.T) {
+	convergents := New(415, 93).Convergents()
+	want := []*Rational{NewFromInt(4), New(9, 2), New(58, 13), New(415, 93)}
+	for i := range want {
+		if !convergents[i].Equals(want[i]) {
+			t.Errorf("Convergents()[%d] = %s, want %s", i, convergents[i], want[i])
+		}
+	}
+}
+
+func TestApproximate(t *testing.T) {
+	tests := []struct {
+		x      float64
+		maxDen int64
+		want   *Rational
+	}{
+		{math.Pi, 10, New(22, 7)},
+		{math.Pi, 1000, New(355, 113)},
+		{0.1, 10, New(1, 10)},
+		{-0.333, 10, New(-1, 3)},
+		{2.54, 100, New(127, 50)},
+		{0.5, 1, NewFromInt(0)},
+		{1.0 / 3, 1 << 20, New(1, 3)},
+	}
+	for _, tt := range tests {
+		if got := Approximate(tt.x, tt.maxDen); !got.Equals(tt.want) {
+			t.Errorf("Approximate(%v, %d) = %s, want %s", tt.x, tt.maxDen, got, tt.want)
+		}
+	}
+
+	// Результат всегда лучше любой другой дроби с допустимым знаменателем
+	x := NewFromBigInt(big.NewInt(31415926), big.NewInt(10000000))
+	best := x.LimitDenominator(50)
+	bestErr := best.Subtract(x).Abs()
+	for q := int64(1); q <= 50; q++ {
+		p := x.Multiply(NewFromInt(q)).Round(bignum.HalfEven)
+		candidate := p.Divide(NewFromInt(q))
+		if candidate.Subtract(x).Abs().Compare(bestErr) < 0 {
+			t.Errorf("%s is closer than %s", candidate, best)
+		}
+	}
+}
+
+func TestRounding(t *testing.T) {
+	tests := []struct {
+		value                 *Rational
+		floor, ceil, halfEven int64
+	}{
+		{New(7, 2), 3, 4, 4},
+		{New(5, 2), 2, 3, 2},
+		{New(-5, 2), -3, -2, -2},
+		{New(-7, 3), -3, -2, -2},
+		{NewFromInt(4), 4, 4, 4},
+	}
+	for _, tt := range tests {
+		if got := tt.value.Floor(); !got.Equals(NewFromInt(tt.floor)) {
+			t.Errorf("Floor(%s) = %s, want %d", tt.value, got, tt.floor)
+		}
+		if got := tt.value.Ceil(); !got.Equals(NewFromInt(tt.ceil)) {
+			t.Errorf("Ceil(%s) = %s, want %d", tt.value, got, tt.ceil)
+		}
+		if got := tt.value.Round(bignum.HalfEven); !got.Equals(NewFromInt(tt.halfEven)) {
+			t.Errorf("Round(%s, HalfEven) = %s, want %d", tt.value, got, tt.halfEven)
+		}
+	}
+	if got := New(-5, 2).Round(bignum.HalfUp); !got.Equals(NewFromInt(-3)) {
+		t.Errorf("Round(-5/2, HalfUp) = %s, want -3", got)
+	}
+}
+
+func TestMod(t *testing.T) {
+	tests := []struct {
+		a, b, want *Rational
+	}{
+		{New(7, 2), NewFromInt(1), New(1, 2)},
+		{New(-1, 3), NewFromInt(1), New(2, 3)},
+		{New(5, 4), New(1, 3), New(1, 4)},
+		{New(1, 2), New(-1, 3), New(-1, 6)},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Mod(tt.b); !got.Equals(tt.want) {
+			t.Errorf("Mod(%s, %s) = %s, want %s", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestMediant(t *testing.T) {
+	a, b := New(1, 3), New(1, 2)
+	m := a.Mediant(b)
+	if !m.Equals(New(2, 5)) {
+		t.Errorf("Mediant() = %s, want 2/5", m)
+	}
+	if m.Compare(a) <= 0 || m.Compare(b) >= 0 {
+		t.Errorf("Mediant() %s is not between %s and %s", m, a, b)
+	}
+}
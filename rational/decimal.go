@@ -0,0 +1,60 @@
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	bignum "types/big"
+)
+
+// Zero returns 0/1. The receiver is not used, so the method can be called on nil
+// to obtain the additive identity in generic code
+func (*Rational) Zero() *Rational {
+	return NewFromInt(0)
+}
+
+// One returns 1/1. The receiver is not used
+func (*Rational) One() *Rational {
+	return NewFromInt(1)
+}
+
+// FromBig converts a big number to an exact rational number
+func FromBig(b *bignum.Big) *Rational {
+	r := b.Rat()
+	return NewFromBigInt(r.Num(), r.Denom())
+}
+
+// ToBig converts the rational number to a decimal with the given number of digits
+// after the point, rounding with mode. The flag reports whether the result is exact
+func (r *Rational) ToBig(scale int32, mode bignum.RoundingMode) (*bignum.Big, bool) {
+	rat := r.rat()
+	result := bignum.FromRat(rat, scale, mode)
+	return result, result.Rat().Cmp(rat) == 0
+}
+
+// ToDecimal converts the rational number to a decimal without rounding.
+// The flag is false when the fraction has no finite decimal expansion (like 1/3)
+func (r *Rational) ToDecimal() (*bignum.Big, bool) {
+	return bignum.FromRatExact(r.rat())
+}
+
+// ParseDecimal parses a decimal string such as "-1.25", "0.001" or "1e-3"
+// into an exact rational number
+func ParseDecimal(s string) (*Rational, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.ContainsAny(s, "/ \t") {
+		return nil, fmt.Errorf("неверная десятичная запись: %q", s)
+	}
+
+	rat, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("неверная десятичная запись: %q", s)
+	}
+	return NewFromBigInt(rat.Num(), rat.Denom()), nil
+}
+
+// rat returns the value as *big.Rat
+func (r *Rational) rat() *big.Rat {
+	return new(big.Rat).SetFrac(r.num, r.den)
+}
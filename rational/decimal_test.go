@@ -0,0 +1,75 @@
+package rational
+
+import (
+	"testing"
+
+	bignum "types/big"
+)
+
+func TestFromBig(t *testing.T) {
+	r := FromBig(bignum.New("-1.250"))
+	if !r.Equals(New(-5, 4)) {
+		t.Errorf("FromBig(-1.250) = %s, want -5/4", r)
+	}
+	if r := FromBig(bignum.New("123456789012345678901234567890")); r.String() != "123456789012345678901234567890" {
+		t.Errorf("FromBig() = %s", r)
+	}
+}
+
+func TestToBig(t *testing.T) {
+	got, exact := New(1, 3).ToBig(5, bignum.HalfEven)
+	if got.String() != "0.33333" || exact {
+		t.Errorf("ToBig(1/3) = %s, %v; want 0.33333, false", got, exact)
+	}
+
+	got, exact = New(3, 8).ToBig(4, bignum.HalfEven)
+	if got.String() != "0.3750" || !exact {
+		t.Errorf("ToBig(3/8) = %s, %v; want 0.3750, true", got, exact)
+	}
+
+	got, _ = New(-2, 3).ToBig(2, bignum.Floor)
+	if got.String() != "-0.67" {
+		t.Errorf("ToBig(-2/3, Floor) = %s, want -0.67", got)
+	}
+}
+
+func TestToDecimal(t *testing.T) {
+	if d, ok := New(7, 40).ToDecimal(); !ok || d.String() != "0.175" {
+		t.Errorf("ToDecimal(7/40) = %v, %v", d, ok)
+	}
+	if _, ok := New(1, 7).ToDecimal(); ok {
+		t.Errorf("ToDecimal(1/7) expected to be inexact")
+	}
+
+	// Полный цикл без потери точности
+	r := New(-123456789, 1024)
+	d, _ := r.ToDecimal()
+	if !FromBig(d).Equals(r) {
+		t.Errorf("round trip failed: %s -> %s", r, d)
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		input string
+		want  *Rational
+	}{
+		{"-1.25", New(-5, 4)},
+		{"0.001", New(1, 1000)},
+		{"1e-3", New(1, 1000)},
+		{"2.5E2", NewFromInt(250)},
+		{" 42 ", NewFromInt(42)},
+	}
+	for _, tt := range tests {
+		got, err := ParseDecimal(tt.input)
+		if err != nil || !got.Equals(tt.want) {
+			t.Errorf("ParseDecimal(%q) = %v, %v; want %s", tt.input, got, err, tt.want)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "3/4", "1.2.3"} {
+		if _, err := ParseDecimal(input); err == nil {
+			t.Errorf("ParseDecimal(%q) expected error", input)
+		}
+	}
+}
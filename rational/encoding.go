@@ -0,0 +1,83 @@
+package rational
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// orZero returns 0/1 for the zero value Rational{}
+func (r *Rational) orZero() *Rational {
+	if r.num == nil || r.den == nil {
+		return NewFromInt(0)
+	}
+	return r
+}
+
+// MarshalText implements encoding.TextMarshaler using the "a/b" form
+func (r Rational) MarshalText() ([]byte, error) {
+	return []byte(r.orZero().String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting every form of Parse
+func (r *Rational) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*r = *parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The value is written as a string ("3/4"),
+// because a JSON number cannot hold an arbitrary fraction exactly
+func (r Rational) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.orZero().String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Both strings ("3/4", "0.(3)")
+// and JSON numbers (0.25) are accepted; numbers are read exactly from their text
+func (r *Rational) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	return r.UnmarshalText(data)
+}
+
+// Value implements driver.Valuer. The fraction is stored as text "a/b"
+func (r Rational) Value() (driver.Value, error) {
+	return r.orZero().String(), nil
+}
+
+// Scan implements sql.Scanner. Text in any form of Parse, integers and floats are accepted;
+// floats are converted exactly from their binary value
+func (r *Rational) Scan(value any) error {
+	switch v := value.(type) {
+	case string:
+		return r.UnmarshalText([]byte(v))
+	case []byte:
+		return r.UnmarshalText(v)
+	case int64:
+		*r = *NewFromInt(v)
+	case float64:
+		f := new(big.Rat)
+		if f.SetFloat64(v) == nil {
+			return fmt.Errorf("rational: невозможно прочитать %v", v)
+		}
+		*r = *NewFromBigInt(f.Num(), f.Denom())
+	default:
+		return fmt.Errorf("rational: неподдерживаемый тип %T для Scan", value)
+	}
+	return nil
+}
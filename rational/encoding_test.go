@@ -0,0 +1,68 @@
+package rational
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	type reading struct {
+		Ratio Rational  `json:"ratio"`
+		Scale *Rational `json:"scale"`
+	}
+
+	data, err := json.Marshal(reading{Ratio: *New(2, 3), Scale: New(-1, 4)})
+	if err != nil || string(data) != `{"ratio":"2/3","scale":"-1/4"}` {
+		t.Errorf("Marshal() = %s, %v", data, err)
+	}
+
+	var out reading
+	if err := json.Unmarshal([]byte(`{"ratio":"0.(6)","scale":-0.25}`), &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !out.Ratio.Equals(New(2, 3)) || !out.Scale.Equals(New(-1, 4)) {
+		t.Errorf("Unmarshal() = %s, %s", &out.Ratio, out.Scale)
+	}
+
+	if err := json.Unmarshal([]byte(`{"ratio":"x"}`), &out); err == nil {
+		t.Errorf("Unmarshal() expected error")
+	}
+}
+
+func TestText(t *testing.T) {
+	var zero Rational
+	if text, err := zero.MarshalText(); err != nil || string(text) != "0" {
+		t.Errorf("MarshalText() = %s, %v", text, err)
+	}
+
+	var r Rational
+	if err := r.UnmarshalText([]byte("5/10")); err != nil || !r.Equals(New(1, 2)) {
+		t.Errorf("UnmarshalText() = %s, %v", &r, err)
+	}
+}
+
+func TestSQL(t *testing.T) {
+	v, err := New(7, 3).Value()
+	if err != nil || v != "7/3" {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+
+	var r Rational
+	tests := []struct {
+		src  any
+		want *Rational
+	}{
+		{"7/3", New(7, 3)},
+		{[]byte("1.5"), New(3, 2)},
+		{int64(-4), NewFromInt(-4)},
+		{0.125, New(1, 8)},
+	}
+	for _, tt := range tests {
+		if err := r.Scan(tt.src); err != nil || !r.Equals(tt.want) {
+			t.Errorf("Scan(%v) = %s, %v; want %s", tt.src, &r, err, tt.want)
+		}
+	}
+	if err := r.Scan(nil); err == nil {
+		t.Errorf("Scan(nil) expected error")
+	}
+}
@@ -0,0 +1,137 @@
+package rational
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Parse parses a rational number in one of the forms:
+// a fraction "3/4" or "-3/4", a decimal "-1.25" or "1e-3",
+// or a repeating decimal with the cycle in parentheses "0.(142857)" or "1.2(3)"
+func Parse(s string) (*Rational, error) {
+	s = strings.TrimSpace(s)
+
+	if numText, denText, ok := strings.Cut(s, "/"); ok {
+		num, okNum := new(big.Int).SetString(strings.TrimSpace(numText), 10)
+		den, okDen := new(big.Int).SetString(strings.TrimSpace(denText), 10)
+		if !okNum || !okDen {
+			return nil, fmt.Errorf("неверная запись дроби: %q", s)
+		}
+		if den.Sign() == 0 {
+			return nil, fmt.Errorf("знаменатель не может быть равен нулю: %q", s)
+		}
+		return NewFromBigInt(num, den), nil
+	}
+
+	if strings.HasSuffix(s, ")") {
+		return parseRepeating(s)
+	}
+	return ParseDecimal(s)
+}
+
+// parseRepeating parses a repeating decimal "I.F(R)":
+// value = I.F + R / (10^len(F) * (10^len(R) - 1))
+func parseRepeating(s string) (*Rational, error) {
+	fail := func() (*Rational, error) {
+		return nil, fmt.Errorf("неверная запись периодической дроби: %q", s)
+	}
+
+	open := strings.IndexByte(s, '(')
+	if open < 0 {
+		return fail()
+	}
+	prefix, cycle := s[:open], s[open+1:len(s)-1]
+	if cycle == "" || !isDigits(cycle) || !strings.Contains(prefix, ".") {
+		return fail()
+	}
+
+	neg := strings.HasPrefix(prefix, "-")
+	prefix = strings.TrimLeft(prefix, "+-")
+	intPart, fracPart, _ := strings.Cut(prefix, ".")
+	if intPart == "" {
+		intPart = "0"
+	}
+	if !isDigits(intPart) || (fracPart != "" && !isDigits(fracPart)) {
+		return fail()
+	}
+
+	base, _ := new(big.Rat).SetString(intPart + "." + fracPart + "0")
+	repeat, _ := new(big.Int).SetString(cycle, 10)
+	den := new(big.Int).Sub(pow10(len(cycle)), big.NewInt(1))
+	den.Mul(den, pow10(len(fracPart)))
+
+	value := new(big.Rat).Add(base, new(big.Rat).SetFrac(repeat, den))
+	if neg {
+		value.Neg(value)
+	}
+	return NewFromBigInt(value.Num(), value.Denom()), nil
+}
+
+// FormatDecimal renders the number in decimal notation with at most digits
+// digits after the point. Terminating fractions are printed exactly ("0.375"),
+// a repeating cycle is put in parentheses ("0.(142857)", "0.1(6)"). If neither
+// fits into digits, the expansion is truncated and followed by "..."
+func (r *Rational) FormatDecimal(digits int) string {
+	var sb strings.Builder
+	if r.num.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+
+	num := new(big.Int).Abs(r.num)
+	intPart, rem := new(big.Int).QuoRem(num, r.den, new(big.Int))
+	sb.WriteString(intPart.String())
+	if rem.Sign() == 0 {
+		return sb.String()
+	}
+
+	// Длинное деление: повтор остатка означает начало периода
+	seen := make(map[string]int)
+	var frac []byte
+	ten := big.NewInt(10)
+	digit := new(big.Int)
+	for rem.Sign() != 0 && len(frac) < digits {
+		key := rem.String()
+		if start, ok := seen[key]; ok {
+			sb.WriteByte('.')
+			sb.Write(frac[:start])
+			sb.WriteByte('(')
+			sb.Write(frac[start:])
+			sb.WriteByte(')')
+			return sb.String()
+		}
+		seen[key] = len(frac)
+
+		rem.Mul(rem, ten)
+		digit.QuoRem(rem, r.den, rem)
+		frac = append(frac, byte('0'+digit.Int64()))
+	}
+
+	if len(frac) > 0 {
+		sb.WriteByte('.')
+		sb.Write(frac)
+	}
+	if rem.Sign() != 0 {
+		// Период мог замкнуться ровно на последней цифре
+		if start, ok := seen[rem.String()]; ok {
+			return strings.TrimSuffix(sb.String(), string(frac)) + string(frac[:start]) + "(" + string(frac[start:]) + ")"
+		}
+		sb.WriteString("...")
+	}
+	return sb.String()
+}
+
+// isDigits reports whether s consists only of ASCII digits
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// pow10 returns 10^n
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,70 @@
+package rational
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  *Rational
+	}{
+		{"3/4", New(3, 4)},
+		{"-6/8", New(-3, 4)},
+		{"3/-4", New(-3, 4)},
+		{"-1.25", New(-5, 4)},
+		{"1e-3", New(1, 1000)},
+		{"0.(142857)", New(1, 7)},
+		{"0.(3)", New(1, 3)},
+		{"1.2(3)", New(37, 30)},
+		{"-0.1(6)", New(-1, 6)},
+		{".(9)", NewFromInt(1)},
+		{"7", NewFromInt(7)},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.input, err)
+			continue
+		}
+		if !got.Equals(tt.want) {
+			t.Errorf("Parse(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+
+	for _, input := range []string{"", "1/0", "a/b", "0.(", "0.()", "1(3)", "0.(1a)", "1.5.5"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) expected error", input)
+		}
+	}
+}
+
+func TestFormatDecimal(t *testing.T) {
+	tests := []struct {
+		value  *Rational
+		digits int
+		want   string
+	}{
+		{New(3, 8), 10, "0.375"},
+		{New(1, 7), 10, "0.(142857)"},
+		{New(1, 6), 10, "0.1(6)"},
+		{New(-37, 30), 10, "-1.2(3)"},
+		{NewFromInt(42), 10, "42"},
+		{New(1, 3), 1, "0.(3)"},
+		{New(1, 7), 3, "0.142..."},
+		{New(1, 1024), 4, "0.0009..."},
+	}
+	for _, tt := range tests {
+		if got := tt.value.FormatDecimal(tt.digits); got != tt.want {
+			t.Errorf("FormatDecimal(%s, %d) = %s, want %s", tt.value, tt.digits, got, tt.want)
+		}
+	}
+
+	// Результат FormatDecimal разбирается обратно в то же число
+	for _, r := range []*Rational{New(22, 7), New(-5, 12), New(1, 81)} {
+		back, err := Parse(r.FormatDecimal(100))
+		if err != nil || !back.Equals(r) {
+			t.Errorf("round trip %s -> %s -> %v, %v", r, r.FormatDecimal(100), back, err)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package result
+
+// Pair - пара значений, результат Zip
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+// Map применяет функцию к значению Ok, меняя тип значения: Result[T] -> Result[U].
+// Ошибка передается без изменений
+func Map[T, U any](r Result[T], fn func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(fn(r.value))
+}
+
+// AndThen вызывает функцию, возвращающую Result другого типа, если r - Ok.
+// Позволяет строить цепочки шагов вроде разбор строки -> число -> сущность
+func AndThen[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return fn(r.value)
+}
+
+// MapErr преобразует ошибку, если r - Err; значение Ok передается без изменений
+func MapErr[T any](r Result[T], fn func(error) error) Result[T] {
+	if r.err != nil {
+		return Err[T](fn(r.err))
+	}
+	return r
+}
+
+// Flatten убирает один уровень вложенности: Result[Result[T]] -> Result[T]
+func Flatten[T any](r Result[Result[T]]) Result[T] {
+	if r.err != nil {
+		return Err[T](r.err)
+	}
+	return r.value
+}
+
+// Zip объединяет два Result в пару. Если оба содержат ошибку, возвращается первая
+func Zip[T, U any](a Result[T], b Result[U]) Result[Pair[T, U]] {
+	if a.err != nil {
+		return Err[Pair[T, U]](a.err)
+	}
+	if b.err != nil {
+		return Err[Pair[T, U]](b.err)
+	}
+	return Ok(Pair[T, U]{First: a.value, Second: b.value})
+}
+
+// Collect собирает значения всех Ok в срез. Если есть ошибки, возвращается первая из них
+func Collect[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(results))
+	for _, r := range results {
+		if r.err != nil {
+			return Err[[]T](r.err)
+		}
+		values = append(values, r.value)
+	}
+	return Ok(values)
+}
+
+// Partition разделяет результаты на значения Ok и ошибки, сохраняя порядок
+func Partition[T any](results []Result[T]) ([]T, []error) {
+	var values []T
+	var errs []error
+	for _, r := range results {
+		if r.err != nil {
+			errs = append(errs, r.err)
+		} else {
+			values = append(values, r.value)
+		}
+	}
+	return values, errs
+}
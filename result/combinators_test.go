@@ -0,0 +1,199 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func parseInt(s string) Result[int] {
+	return Func(func() (int, error) { return strconv.Atoi(s) })
+}
+
+func TestMapTypeChanging(t *testing.T) {
+	r := Map(Ok(21), func(x int) string { return strconv.Itoa(x * 2) })
+	if v, err := r.Unwrap(); err != nil || v != "42" {
+		t.Errorf("Map() = %q, %v, want \"42\"", v, err)
+	}
+
+	testErr := errors.New("test error")
+	called := false
+	r = Map(Err[int](testErr), func(x int) string { called = true; return "" })
+	if _, err := r.Unwrap(); err != testErr || called {
+		t.Errorf("Map(Err) = %v, called = %v", err, called)
+	}
+}
+
+func TestAndThenTypeChanging(t *testing.T) {
+	r := AndThen(Ok("42"), parseInt)
+	if v, err := r.Unwrap(); err != nil || v != 42 {
+		t.Errorf("AndThen() = %d, %v, want 42", v, err)
+	}
+
+	r = AndThen(Ok("abc"), parseInt)
+	if !r.Err() {
+		t.Errorf("AndThen() with failing step should be Err")
+	}
+
+	testErr := errors.New("test error")
+	r = AndThen(Err[string](testErr), parseInt)
+	if _, err := r.Unwrap(); err != testErr {
+		t.Errorf("AndThen(Err) error = %v, want %v", err, testErr)
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	testErr := errors.New("test error")
+	r := MapErr(Err[int](testErr), func(err error) error { return fmt.Errorf("wrapped: %w", err) })
+	if _, err := r.Unwrap(); !errors.Is(err, testErr) || err.Error() != "wrapped: test error" {
+		t.Errorf("MapErr() error = %v", err)
+	}
+
+	r = MapErr(Ok(1), func(err error) error { t.Error("fn called for Ok"); return err })
+	if v, _ := r.Unwrap(); v != 1 {
+		t.Errorf("MapErr(Ok) = %d, want 1", v)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	testErr := errors.New("test error")
+	tests := []struct {
+		name  string
+		input Result[Result[int]]
+		want  int
+		err   error
+	}{
+		{"ok ok", Ok(Ok(1)), 1, nil},
+		{"ok err", Ok(Err[int](testErr)), 0, testErr},
+		{"err", Err[Result[int]](testErr), 0, testErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := Flatten(tt.input).Unwrap()
+			if v != tt.want || err != tt.err {
+				t.Errorf("Flatten() = %d, %v, want %d, %v", v, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	pair, err := Zip(Ok(1), Ok("a")).Unwrap()
+	if err != nil || pair != (Pair[int, string]{First: 1, Second: "a"}) {
+		t.Errorf("Zip() = %+v, %v", pair, err)
+	}
+
+	first, second := errors.New("first"), errors.New("second")
+	if _, err := Zip(Err[int](first), Err[string](second)).Unwrap(); err != first {
+		t.Errorf("Zip(Err, Err) error = %v, want first", err)
+	}
+	if _, err := Zip(Ok(1), Err[string](second)).Unwrap(); err != second {
+		t.Errorf("Zip(Ok, Err) error = %v, want second", err)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	values, err := Collect([]Result[int]{Ok(1), Ok(2), Ok(3)}).Unwrap()
+	if err != nil || !reflect.DeepEqual(values, []int{1, 2, 3}) {
+		t.Errorf("Collect() = %v, %v", values, err)
+	}
+
+	values, err = Collect[int](nil).Unwrap()
+	if err != nil || values == nil || len(values) != 0 {
+		t.Errorf("Collect(nil) = %#v, %v, want empty slice", values, err)
+	}
+
+	first, second := errors.New("first"), errors.New("second")
+	if _, err := Collect([]Result[int]{Ok(1), Err[int](first), Err[int](second)}).Unwrap(); err != first {
+		t.Errorf("Collect() error = %v, want first", err)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	first, second := errors.New("first"), errors.New("second")
+	values, errs := Partition([]Result[int]{Ok(1), Err[int](first), Ok(2), Err[int](second)})
+	if !reflect.DeepEqual(values, []int{1, 2}) {
+		t.Errorf("Partition() values = %v", values)
+	}
+	if !reflect.DeepEqual(errs, []error{first, second}) {
+		t.Errorf("Partition() errors = %v", errs)
+	}
+}
+
+type orderError int
+
+const (
+	orderNotFound orderError = iota
+	orderCancelled
+)
+
+func (e orderError) String() string {
+	return [...]string{"not found", "cancelled"}[e]
+}
+
+func findOrder(id int) Result2[string, orderError] {
+	switch id {
+	case 1:
+		return Ok2[string, orderError]("order-1")
+	case 2:
+		return Err2[string](orderCancelled)
+	}
+	return Err2[string](orderNotFound)
+}
+
+func TestResult2(t *testing.T) {
+	ok := findOrder(1)
+	if !ok.Ok() || ok.Err() {
+		t.Errorf("findOrder(1) should be Ok")
+	}
+	if v, _, isOk := ok.Unwrap(); v != "order-1" || !isOk {
+		t.Errorf("Unwrap() = %q, %v", v, isOk)
+	}
+
+	// Нулевое значение E - тоже ошибка
+	missing := findOrder(3)
+	if missing.Ok() || missing.UnwrapErr() != orderNotFound {
+		t.Errorf("findOrder(3) = %+v, want Err(orderNotFound)", missing)
+	}
+	if got := missing.UnwrapOr("none"); got != "none" {
+		t.Errorf("UnwrapOr() = %q", got)
+	}
+	if got := missing.UnwrapOrElse(func(e orderError) string { return e.String() }); got != "not found" {
+		t.Errorf("UnwrapOrElse() = %q", got)
+	}
+	if got := missing.Or(ok); got != ok {
+		t.Errorf("Or() = %+v", got)
+	}
+	if got := missing.Match(func(string) any { return "ok" }, func(orderError) any { return "err" }); got != "err" {
+		t.Errorf("Match() = %v", got)
+	}
+
+	length := Map2(ok, func(s string) int { return len(s) })
+	if v, _, _ := length.Unwrap(); v != 7 {
+		t.Errorf("Map2() = %d, want 7", v)
+	}
+	chained := AndThen2(ok, func(string) Result2[int, orderError] { return Err2[int](orderCancelled) })
+	if chained.UnwrapErr() != orderCancelled {
+		t.Errorf("AndThen2() error = %v", chained.UnwrapErr())
+	}
+	code := MapErr2(findOrder(2), func(e orderError) int { return 400 + int(e) })
+	if code.UnwrapErr() != 401 {
+		t.Errorf("MapErr2() error = %d, want 401", code.UnwrapErr())
+	}
+
+	_, err := ToResult(findOrder(2), func(e orderError) error { return errors.New(e.String()) }).Unwrap()
+	if err == nil || err.Error() != "cancelled" {
+		t.Errorf("ToResult() error = %v", err)
+	}
+}
+
+func TestResult2Panics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "lookup: cancelled" {
+			t.Errorf("Expect() panic = %v", r)
+		}
+	}()
+	findOrder(2).Expect("lookup")
+}
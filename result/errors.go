@@ -0,0 +1,117 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"strings"
+)
+
+// PanicError - ошибка, в которую Try превращает панику. Value - значение,
+// переданное в panic, Stack - стек горутины в момент паники
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+// Error возвращает описание паники
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("паника: %v", e.Value)
+}
+
+// Unwrap возвращает значение паники, если оно является ошибкой
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Try выполняет функцию как Func, но перехватывает панику и возвращает ее
+// как Err с *PanicError, содержащим значение паники и стек
+func Try[T any](fn func() (T, error)) (r Result[T]) {
+	defer func() {
+		if value := recover(); value != nil {
+			r = Err[T](&PanicError{Value: value, Stack: debug.Stack()})
+		}
+	}()
+	return Func(fn)
+}
+
+// Context добавляет к ошибке пояснение: "msg: err". Исходная ошибка
+// остается в цепочке и доступна через errors.Is и errors.As. Ok не меняется
+func (r Result[T]) Context(msg string) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return Err[T](fmt.Errorf("%s: %w", msg, r.err))
+}
+
+// Wrap связывает ошибку с sentinel-ошибкой предметной области: "sentinel: err".
+// После этого errors.Is находит и sentinel, и исходную ошибку. Ok не меняется
+func (r Result[T]) Wrap(sentinel error) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return Err[T](fmt.Errorf("%w: %w", sentinel, r.err))
+}
+
+// Is сообщает, содержит ли Result ошибку, совпадающую с target по errors.Is
+func (r Result[T]) Is(target error) bool {
+	return r.err != nil && errors.Is(r.err, target)
+}
+
+// As ищет в цепочке ошибки Result ошибку типа target, как errors.As.
+// Для Ok возвращает false
+func (r Result[T]) As(target any) bool {
+	return r.err != nil && errors.As(r.err, target)
+}
+
+// Format реализует fmt.Formatter: %v и %s печатают Ok(value) или Err(message),
+// %+v для ошибки дополнительно печатает цепочку причин и стек паники, если он есть
+func (r Result[T]) Format(f fmt.State, verb rune) {
+	if r.err == nil {
+		fmt.Fprintf(f, "Ok(%s)", fmt.Sprintf(fmt.FormatString(f, verb), r.value))
+		return
+	}
+
+	fmt.Fprintf(f, "Err(%s)", r.err.Error())
+	if verb != 'v' || !f.Flag('+') {
+		return
+	}
+	writeChain(f, r.err)
+}
+
+// writeChain печатает причины ошибки, по одной на строке, а затем стек
+// первой найденной в цепочке паники
+func writeChain(w io.Writer, err error) {
+	var stack []byte
+	writeCauses(w, err, 0, &stack)
+	if stack != nil {
+		fmt.Fprintf(w, "\nстек:\n%s", stack)
+	}
+}
+
+// writeCauses рекурсивно обходит цепочку. Ошибки с Unwrap() []error
+// (fmt.Errorf с несколькими %w, errors.Join) печатаются ветвями с отступом
+func writeCauses(w io.Writer, err error, depth int, stack *[]byte) {
+	if panicErr, ok := err.(*PanicError); ok && *stack == nil {
+		*stack = panicErr.Stack
+	}
+
+	var causes []error
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		if cause := e.Unwrap(); cause != nil {
+			causes = []error{cause}
+		}
+	case interface{ Unwrap() []error }:
+		causes = e.Unwrap()
+		if len(causes) > 1 {
+			depth++
+		}
+	}
+	for _, cause := range causes {
+		fmt.Fprintf(w, "\n%sпричина: %s", strings.Repeat("  ", depth), cause.Error())
+		writeCauses(w, cause, depth, stack)
+	}
+}
@@ -0,0 +1,134 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+var errNotFound = errors.New("not found")
+
+func TestTry(t *testing.T) {
+	r := Try(func() (int, error) { return 42, nil })
+	if v, err := r.Unwrap(); v != 42 || err != nil {
+		t.Errorf("Try() = %d, %v, want 42", v, err)
+	}
+
+	r = Try(func() (int, error) { return 0, errNotFound })
+	if !r.Is(errNotFound) {
+		t.Errorf("Try() should pass errors through, got %v", r)
+	}
+
+	r = Try(func() (int, error) {
+		var m map[string]int
+		m["x"] = 1 // паника: запись в nil map
+		return 1, nil
+	})
+	var panicErr *PanicError
+	if !r.As(&panicErr) {
+		t.Fatalf("Try() with panic = %v, want *PanicError", r)
+	}
+	if !strings.Contains(panicErr.Error(), "nil map") {
+		t.Errorf("PanicError.Error() = %q", panicErr.Error())
+	}
+	if !strings.Contains(string(panicErr.Stack), "TestTry") {
+		t.Errorf("PanicError.Stack does not contain the test function:\n%s", panicErr.Stack)
+	}
+
+	// Паника со значением-ошибкой остается доступной через errors.Is
+	r = Try(func() (int, error) { panic(errNotFound) })
+	if !r.Is(errNotFound) {
+		t.Errorf("Try() with panic(error) should unwrap to it, got %v", r)
+	}
+}
+
+func TestContextAndWrap(t *testing.T) {
+	r := Err[int](fs.ErrNotExist).Context("чтение конфигурации").Context("запуск")
+	if _, err := r.Unwrap(); err.Error() != "запуск: чтение конфигурации: file does not exist" {
+		t.Errorf("Context() error = %v", err)
+	}
+	if !r.Is(fs.ErrNotExist) {
+		t.Errorf("Context() should keep the cause in the chain")
+	}
+
+	wrapped := Err[int](fs.ErrNotExist).Wrap(errNotFound)
+	if !wrapped.Is(errNotFound) || !wrapped.Is(fs.ErrNotExist) {
+		t.Errorf("Wrap() should match both errors, got %v", wrapped)
+	}
+	if _, err := wrapped.Unwrap(); err.Error() != "not found: file does not exist" {
+		t.Errorf("Wrap() error = %v", err)
+	}
+
+	ok := Ok(1).Context("не используется").Wrap(errNotFound)
+	if v, err := ok.Unwrap(); v != 1 || err != nil {
+		t.Errorf("Context/Wrap on Ok = %d, %v", v, err)
+	}
+	if ok.Is(errNotFound) || ok.As(new(*PanicError)) {
+		t.Errorf("Is/As on Ok should be false")
+	}
+}
+
+func TestAs(t *testing.T) {
+	pathErr := &fs.PathError{Op: "open", Path: "config.yaml", Err: fs.ErrNotExist}
+	r := Err[string](pathErr).Context("загрузка")
+
+	var target *fs.PathError
+	if !r.As(&target) || target.Path != "config.yaml" {
+		t.Errorf("As() = %v, target = %v", r.As(&target), target)
+	}
+}
+
+func TestExpectWrapsError(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, errNotFound) || err.Error() != "пользователь: not found" {
+			t.Errorf("Expect() panic = %v", err)
+		}
+	}()
+	Err[int](errNotFound).Expect("пользователь")
+}
+
+func TestFormat(t *testing.T) {
+	if got := fmt.Sprintf("%v", Ok(42)); got != "Ok(42)" {
+		t.Errorf("%%v of Ok = %q", got)
+	}
+	if got := fmt.Sprintf("%+v", Ok(struct{ A int }{1})); got != "Ok({A:1})" {
+		t.Errorf("%%+v of Ok = %q", got)
+	}
+	if got := fmt.Sprintf("%q", Ok("x")); got != `Ok("x")` {
+		t.Errorf("%%q of Ok = %q", got)
+	}
+
+	r := Err[int](errNotFound).Context("поиск").Context("запрос")
+	if got := fmt.Sprintf("%v", r); got != "Err(запрос: поиск: not found)" {
+		t.Errorf("%%v of Err = %q", got)
+	}
+	if got := fmt.Sprint(r); got != "Err(запрос: поиск: not found)" {
+		t.Errorf("Sprint of Err = %q", got)
+	}
+
+	want := "Err(запрос: поиск: not found)\n" +
+		"причина: поиск: not found\n" +
+		"причина: not found"
+	if got := fmt.Sprintf("%+v", r); got != want {
+		t.Errorf("%%+v of Err = %q, want %q", got, want)
+	}
+
+	// Несколько %w: ветви печатаются с отступом
+	wrapped := Err[int](fs.ErrNotExist).Wrap(errNotFound).Context("загрузка")
+	want = "Err(загрузка: not found: file does not exist)\n" +
+		"причина: not found: file does not exist\n" +
+		"  причина: not found\n" +
+		"  причина: file does not exist"
+	if got := fmt.Sprintf("%+v", wrapped); got != want {
+		t.Errorf("%%+v of wrapped = %q, want %q", got, want)
+	}
+
+	panicked := Try(func() (int, error) { panic("boom") }).Context("обработка")
+	got := fmt.Sprintf("%+v", panicked)
+	if !strings.HasPrefix(got, "Err(обработка: паника: boom)\nпричина: паника: boom\nстек:\n") || !strings.Contains(got, "TestFormat") {
+		t.Errorf("%%+v of panic = %q", got)
+	}
+}
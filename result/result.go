@@ -1,5 +1,7 @@
 package result
 
+import "fmt"
+
 // Result представляет тип, который может содержать либо значение типа T, либо ошибку
 type Result[T any] struct {
 	value T
@@ -47,10 +49,11 @@ func (r Result[T]) UnwrapOrElse(fn func(error) T) T {
 	return r.value
 }
 
-// Expect returns the value if successful, otherwise panics with the provided message
+// Expect returns the value if successful, otherwise panics with an error
+// "msg: err" that wraps the original one, so a recovered value works with errors.Is
 func (r Result[T]) Expect(msg string) T {
 	if r.err != nil {
-		panic(msg + ": " + r.err.Error())
+		panic(fmt.Errorf("%s: %w", msg, r.err))
 	}
 	return r.value
 }
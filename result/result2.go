@@ -0,0 +1,117 @@
+package result
+
+import "fmt"
+
+// Result2 - вариант Result с произвольным типом ошибки E, например перечислением
+// ошибок предметной области. В отличие от Result состояние хранится явно,
+// поэтому нулевое значение E тоже может быть ошибкой
+type Result2[T, E any] struct {
+	value T
+	err   E
+	ok    bool
+}
+
+// Ok2 создает Result2 с успешным значением
+func Ok2[T, E any](value T) Result2[T, E] {
+	return Result2[T, E]{value: value, ok: true}
+}
+
+// Err2 создает Result2 с ошибкой
+func Err2[T, E any](err E) Result2[T, E] {
+	return Result2[T, E]{err: err}
+}
+
+// Ok проверяет, содержит ли Result2 успешное значение
+func (r Result2[T, E]) Ok() bool {
+	return r.ok
+}
+
+// Err проверяет, содержит ли Result2 ошибку
+func (r Result2[T, E]) Err() bool {
+	return !r.ok
+}
+
+// Unwrap возвращает значение, ошибку и признак успеха
+func (r Result2[T, E]) Unwrap() (T, E, bool) {
+	return r.value, r.err, r.ok
+}
+
+// UnwrapOr возвращает значение или defaultValue, если Result2 содержит ошибку
+func (r Result2[T, E]) UnwrapOr(defaultValue T) T {
+	if !r.ok {
+		return defaultValue
+	}
+	return r.value
+}
+
+// UnwrapOrElse возвращает значение или результат fn от ошибки
+func (r Result2[T, E]) UnwrapOrElse(fn func(E) T) T {
+	if !r.ok {
+		return fn(r.err)
+	}
+	return r.value
+}
+
+// Expect возвращает значение или паникует с сообщением и ошибкой
+func (r Result2[T, E]) Expect(msg string) T {
+	if !r.ok {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+	return r.value
+}
+
+// UnwrapErr возвращает ошибку, паникует для значения Ok
+func (r Result2[T, E]) UnwrapErr() E {
+	if r.ok {
+		panic("вызван UnwrapErr для значения Ok")
+	}
+	return r.err
+}
+
+// Or возвращает other, если текущий Result2 содержит ошибку
+func (r Result2[T, E]) Or(other Result2[T, E]) Result2[T, E] {
+	if !r.ok {
+		return other
+	}
+	return r
+}
+
+// Match вызывает okFn или errFn в зависимости от состояния
+func (r Result2[T, E]) Match(okFn func(T) any, errFn func(E) any) any {
+	if !r.ok {
+		return errFn(r.err)
+	}
+	return okFn(r.value)
+}
+
+// Map2 применяет функцию к значению Ok, меняя тип значения
+func Map2[T, U, E any](r Result2[T, E], fn func(T) U) Result2[U, E] {
+	if !r.ok {
+		return Err2[U](r.err)
+	}
+	return Ok2[U, E](fn(r.value))
+}
+
+// AndThen2 вызывает функцию, возвращающую Result2 другого типа, если r - Ok
+func AndThen2[T, U, E any](r Result2[T, E], fn func(T) Result2[U, E]) Result2[U, E] {
+	if !r.ok {
+		return Err2[U](r.err)
+	}
+	return fn(r.value)
+}
+
+// MapErr2 преобразует ошибку, меняя ее тип: Result2[T, E] -> Result2[T, F]
+func MapErr2[T, E, F any](r Result2[T, E], fn func(E) F) Result2[T, F] {
+	if !r.ok {
+		return Err2[T](fn(r.err))
+	}
+	return Ok2[T, F](r.value)
+}
+
+// ToResult переводит Result2 в Result, преобразуя ошибку предметной области в error
+func ToResult[T, E any](r Result2[T, E], fn func(E) error) Result[T] {
+	if !r.ok {
+		return Err[T](fn(r.err))
+	}
+	return Ok(r.value)
+}
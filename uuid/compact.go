@@ -0,0 +1,162 @@
+package uuid
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// crockford is the Crockford Base32 alphabet used by ULID: no I, L, O and U
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// base58 is the Bitcoin Base58 alphabet: no 0, O, I and l
+const base58 = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Base32 returns the UUID as 26 characters of Crockford Base32, the ULID text
+// form. The encoding preserves byte order, so strings sort like the UUIDs
+func (uuid UUID) Base32() string {
+	return encodeBase32(uuid)
+}
+
+// FromBase32 parses 26 characters of Crockford Base32. Case is ignored,
+// I and L are read as 1 and O as 0, as the Crockford specification suggests
+func FromBase32(s string) (UUID, error) {
+	return decodeBase32(s)
+}
+
+// Base58 returns the UUID in Bitcoin Base58: at most 22 characters without
+// look-alike symbols. Leading zero bytes are encoded as '1'
+func (uuid UUID) Base58() string {
+	num := uuid
+	zeros := 0
+	for zeros < len(num) && num[zeros] == 0 {
+		zeros++
+	}
+
+	// Repeated division of the 128-bit big-endian number by 58
+	var digits []byte
+	for start := zeros; start < len(num); {
+		remainder := 0
+		for i := start; i < len(num); i++ {
+			acc := remainder<<8 | int(num[i])
+			num[i] = byte(acc / 58)
+			remainder = acc % 58
+		}
+		digits = append(digits, base58[remainder])
+		for start < len(num) && num[start] == 0 {
+			start++
+		}
+	}
+
+	var sb strings.Builder
+	sb.Grow(zeros + len(digits))
+	for range zeros {
+		sb.WriteByte('1')
+	}
+	for i := len(digits) - 1; i >= 0; i-- {
+		sb.WriteByte(digits[i])
+	}
+	return sb.String()
+}
+
+// FromBase58 parses a UUID encoded with Base58
+func FromBase58(s string) (UUID, error) {
+	var uuid UUID
+	if s == "" || len(s) > 22 {
+		return Nil, fmt.Errorf("invalid Base58 UUID length %d", len(s))
+	}
+	for _, c := range []byte(s) {
+		digit := strings.IndexByte(base58, c)
+		if digit < 0 {
+			return Nil, fmt.Errorf("invalid Base58 character %q", c)
+		}
+		// uuid = uuid*58 + digit
+		carry := digit
+		for i := len(uuid) - 1; i >= 0; i-- {
+			acc := int(uuid[i])*58 + carry
+			uuid[i] = byte(acc)
+			carry = acc >> 8
+		}
+		if carry != 0 {
+			return Nil, fmt.Errorf("Base58 value %q exceeds 128 bits", s)
+		}
+	}
+	return uuid, nil
+}
+
+// Base64 returns the UUID in URL-safe Base64 without padding: 22 characters
+func (uuid UUID) Base64() string {
+	return base64.RawURLEncoding.EncodeToString(uuid[:])
+}
+
+// FromBase64 parses 22 characters of URL-safe Base64 without padding
+func FromBase64(s string) (UUID, error) {
+	var uuid UUID
+	if len(s) != base64.RawURLEncoding.EncodedLen(len(uuid)) {
+		return Nil, fmt.Errorf("invalid Base64 UUID length %d", len(s))
+	}
+	if _, err := base64.RawURLEncoding.Strict().Decode(uuid[:], []byte(s)); err != nil {
+		return Nil, fmt.Errorf("invalid Base64 UUID: %w", err)
+	}
+	return uuid, nil
+}
+
+// encodeBase32 encodes 128 bits as 26 Crockford characters. The 130 bits
+// of output start with two zero bits, so the first character is at most '7'
+func encodeBase32(b [16]byte) string {
+	var out [26]byte
+	for i := range out {
+		var v byte
+		for k := 0; k < 5; k++ {
+			pos := i*5 + k - 2 // bit index in b counting from the most significant
+			v <<= 1
+			if pos >= 0 && b[pos/8]>>(7-pos%8)&1 == 1 {
+				v |= 1
+			}
+		}
+		out[i] = crockford[v]
+	}
+	return string(out[:])
+}
+
+// decodeBase32 decodes 26 Crockford characters into 128 bits
+func decodeBase32(s string) ([16]byte, error) {
+	var b [16]byte
+	if len(s) != 26 {
+		return b, fmt.Errorf("invalid Base32 length %d", len(s))
+	}
+	for i := 0; i < len(s); i++ {
+		v, ok := crockfordValue(s[i])
+		if !ok {
+			return [16]byte{}, fmt.Errorf("invalid Base32 character %q", s[i])
+		}
+		if i == 0 && v > 7 {
+			return [16]byte{}, fmt.Errorf("Base32 value %q exceeds 128 bits", s)
+		}
+		for k := 0; k < 5; k++ {
+			pos := i*5 + k - 2
+			if pos >= 0 && v>>(4-k)&1 == 1 {
+				b[pos/8] |= 1 << (7 - pos%8)
+			}
+		}
+	}
+	return b, nil
+}
+
+// crockfordValue returns the value of a Crockford Base32 character
+func crockfordValue(c byte) (byte, bool) {
+	switch c {
+	case 'o', 'O':
+		return 0, true
+	case 'i', 'I', 'l', 'L':
+		return 1, true
+	}
+	if c >= 'a' && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	i := strings.IndexByte(crockford, c)
+	if i < 0 {
+		return 0, false
+	}
+	return byte(i), true
+}
@@ -0,0 +1,98 @@
+package uuid
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestBase32(t *testing.T) {
+	uuid := mustParse(t, "01563e3a-b5d3-d676-4c61-efb99302bd5b")
+	if got := uuid.Base32(); got != "01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+		t.Errorf("Base32() = %s", got)
+	}
+	if got := Max.Base32(); got != "7ZZZZZZZZZZZZZZZZZZZZZZZZZ" {
+		t.Errorf("Max.Base32() = %s", got)
+	}
+
+	// Регистр не важен, I/L читаются как 1, O как 0
+	for _, s := range []string{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "01arz3ndektsv4rrffq69g5fav", "O1ARZ3NDEKTSV4RRFFQ69G5FAV"} {
+		got, err := FromBase32(s)
+		if err != nil || got != uuid {
+			t.Errorf("FromBase32(%q) = %v, %v", s, got, err)
+		}
+	}
+	if got, _ := FromBase32("0000000000000000000000000I"); got[15] != 1 {
+		t.Errorf("FromBase32(I) = %v", got)
+	}
+
+	for _, s := range []string{"", "01ARZ3NDEKTSV4RRFFQ69G5FA", "01ARZ3NDEKTSV4RRFFQ69G5FAU", "80000000000000000000000000"} {
+		if _, err := FromBase32(s); err == nil {
+			t.Errorf("FromBase32(%q) should return error", s)
+		}
+	}
+
+	// Строки сортируются так же, как байты
+	var ids []UUID
+	var texts []string
+	for i := 0; i < 100; i++ {
+		id, _ := New()
+		ids = append(ids, id)
+		texts = append(texts, id.Base32())
+	}
+	sort.Slice(ids, func(i, j int) bool { return string(ids[i][:]) < string(ids[j][:]) })
+	sort.Strings(texts)
+	for i := range ids {
+		if ids[i].Base32() != texts[i] {
+			t.Fatalf("Base32 order differs at %d", i)
+		}
+	}
+}
+
+func TestBase58(t *testing.T) {
+	tests := []struct {
+		uuid UUID
+		want string
+	}{
+		{Nil, "1111111111111111"},
+		{Max, "YcVfxkQb6JRzqk5kF2tNLv"},
+		{UUID{15: 57}, "111111111111111z"},
+		{UUID{15: 58}, "11111111111111121"},
+	}
+	for _, tt := range tests {
+		if got := tt.uuid.Base58(); got != tt.want {
+			t.Errorf("%v.Base58() = %s, want %s", tt.uuid, got, tt.want)
+		}
+		if got, err := FromBase58(tt.want); err != nil || got != tt.uuid {
+			t.Errorf("FromBase58(%s) = %v, %v", tt.want, got, err)
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		id, _ := New()
+		got, err := FromBase58(id.Base58())
+		if err != nil || got != id {
+			t.Fatalf("FromBase58(%s) = %v, %v, want %v", id.Base58(), got, err, id)
+		}
+	}
+
+	for _, s := range []string{"", "0abc", "YcVfxkQb6JRzqk5kF2tNLw", "YcVfxkQb6JRzqk5kF2tNLv1"} {
+		if _, err := FromBase58(s); err == nil {
+			t.Errorf("FromBase58(%q) should return error", s)
+		}
+	}
+}
+
+func TestBase64(t *testing.T) {
+	if got := Max.Base64(); got != "_____________________w" {
+		t.Errorf("Max.Base64() = %s", got)
+	}
+	id, _ := New()
+	if got, err := FromBase64(id.Base64()); err != nil || got != id {
+		t.Errorf("FromBase64() = %v, %v, want %v", got, err, id)
+	}
+	for _, s := range []string{"", "_____________________x", "+____________________w", "_____________________w=="} {
+		if _, err := FromBase64(s); err == nil {
+			t.Errorf("FromBase64(%q) should return error", s)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// MarshalText implements encoding.TextMarshaler using the canonical form
+func (uuid UUID) MarshalText() ([]byte, error) {
+	return []byte(uuid.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler; accepts every form of FromString
+func (uuid *UUID) UnmarshalText(data []byte) error {
+	parsed, err := FromString(string(data))
+	if err != nil {
+		return err
+	}
+	*uuid = parsed
+	return nil
+}
+
+// MarshalJSON encodes the UUID as a JSON string in the canonical form
+func (uuid UUID) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + uuid.String() + `"`), nil
+}
+
+// UnmarshalJSON decodes a JSON string; null leaves the UUID unchanged
+func (uuid *UUID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("invalid UUID JSON: expected string")
+	}
+	return uuid.UnmarshalText(data[1 : len(data)-1])
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler: the 16 raw bytes
+func (uuid UUID) MarshalBinary() ([]byte, error) {
+	return uuid[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler; data must be exactly 16 bytes
+func (uuid *UUID) UnmarshalBinary(data []byte) error {
+	if len(data) != len(uuid) {
+		return fmt.Errorf("invalid UUID byte length %d", len(data))
+	}
+	copy(uuid[:], data)
+	return nil
+}
+
+// Scan implements sql.Scanner. Supports 16-byte binary columns, text columns
+// (string or []byte in any form of FromString) and NULL, which gives Nil
+func (uuid *UUID) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*uuid = Nil
+		return nil
+	case string:
+		return uuid.UnmarshalText([]byte(v))
+	case []byte:
+		if len(v) == len(uuid) {
+			return uuid.UnmarshalBinary(v)
+		}
+		return uuid.UnmarshalText(v)
+	}
+	return fmt.Errorf("cannot scan %T into UUID", src)
+}
+
+// Value implements driver.Valuer, storing the UUID in the canonical string form
+// accepted by PostgreSQL uuid and text columns. Use Bytes for binary columns
+func (uuid UUID) Value() (driver.Value, error) {
+	return uuid.String(), nil
+}
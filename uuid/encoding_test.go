@@ -0,0 +1,142 @@
+package uuid
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler     = UUID{}
+	_ encoding.TextUnmarshaler   = (*UUID)(nil)
+	_ encoding.BinaryMarshaler   = UUID{}
+	_ encoding.BinaryUnmarshaler = (*UUID)(nil)
+	_ json.Marshaler             = UUID{}
+	_ json.Unmarshaler           = (*UUID)(nil)
+	_ sql.Scanner                = (*UUID)(nil)
+	_ driver.Valuer              = UUID{}
+)
+
+const canonical = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+
+func TestFromStringForms(t *testing.T) {
+	want := mustParse(t, canonical)
+	for _, s := range []string{
+		"F47AC10B-58CC-4372-A567-0E02B2C3D479",
+		"f47ac10b58cc4372a5670e02b2c3d479",
+		"urn:uuid:" + canonical,
+		"URN:UUID:" + canonical,
+		"{" + canonical + "}",
+		"{f47ac10b58cc4372a5670e02b2c3d479}",
+	} {
+		got, err := FromString(s)
+		if err != nil || got != want {
+			t.Errorf("FromString(%q) = %v, %v, want %v", s, got, err, want)
+		}
+	}
+
+	for _, s := range []string{
+		"f47ac10b5-8cc-4372-a567-0e02b2c3d479",    // hyphen moved
+		"f47ac10b-58cc-4372-a567-0e02b2c3d47-9",   // extra hyphen
+		"f47ac10b-58cc4372-a5670e02-b2c3d479",     // hyphens in wrong places, wrong length
+		"f47ac10b-58cc-4372-a567-0e02b2c3d47g",    // not hex
+		"{" + canonical,                           // unbalanced brace
+		"urn:uuid:{" + canonical + "}",            // prefix and braces together
+		"-f47ac10b58cc4372a5670e02b2c3d479",       // leading hyphen
+		"f47ac10b-58cc-4372-a567-0e02b2c3d479   ", // trailing spaces
+	} {
+		if _, err := FromString(s); err == nil {
+			t.Errorf("FromString(%q) should return error", s)
+		}
+	}
+}
+
+func TestJSONAndText(t *testing.T) {
+	uuid := mustParse(t, canonical)
+	type record struct {
+		ID  UUID  `json:"id"`
+		Ref *UUID `json:"ref"`
+	}
+
+	data, err := json.Marshal(record{ID: uuid})
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	if string(data) != `{"id":"`+canonical+`","ref":null}` {
+		t.Errorf("json.Marshal() = %s", data)
+	}
+
+	var got record
+	if err := json.Unmarshal([]byte(`{"id":"{F47AC10B-58CC-4372-A567-0E02B2C3D479}","ref":null}`), &got); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+	if got.ID != uuid || got.Ref != nil {
+		t.Errorf("json.Unmarshal() = %+v", got)
+	}
+	if err := json.Unmarshal([]byte(`{"id":42}`), &got); err == nil {
+		t.Errorf("json.Unmarshal(number) should return error")
+	}
+
+	// Ключи map используют TextMarshaler
+	keys, _ := json.Marshal(map[UUID]int{uuid: 1})
+	if string(keys) != `{"`+canonical+`":1}` {
+		t.Errorf("json.Marshal(map) = %s", keys)
+	}
+
+	var text UUID
+	if err := text.UnmarshalText([]byte("urn:uuid:" + canonical)); err != nil || text != uuid {
+		t.Errorf("UnmarshalText() = %v, %v", text, err)
+	}
+}
+
+func TestBinary(t *testing.T) {
+	uuid := mustParse(t, canonical)
+	data, _ := uuid.MarshalBinary()
+	var got UUID
+	if err := got.UnmarshalBinary(data); err != nil || got != uuid {
+		t.Errorf("UnmarshalBinary() = %v, %v", got, err)
+	}
+	if err := got.UnmarshalBinary(data[:15]); err == nil {
+		t.Errorf("UnmarshalBinary(15 bytes) should return error")
+	}
+}
+
+func TestSQL(t *testing.T) {
+	uuid := mustParse(t, canonical)
+	raw, _ := uuid.MarshalBinary()
+
+	tests := []struct {
+		name string
+		src  any
+		want UUID
+	}{
+		{"string", canonical, uuid},
+		{"text bytes", []byte(canonical), uuid},
+		{"binary", bytes.Clone(raw), uuid},
+		{"null", nil, Nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Max
+			if err := got.Scan(tt.src); err != nil || got != tt.want {
+				t.Errorf("Scan(%v) = %v, %v, want %v", tt.src, got, err, tt.want)
+			}
+		})
+	}
+
+	var got UUID
+	if err := got.Scan(42); err == nil {
+		t.Errorf("Scan(int) should return error")
+	}
+	if err := got.Scan("not a uuid"); err == nil {
+		t.Errorf("Scan(invalid) should return error")
+	}
+
+	value, err := uuid.Value()
+	if err != nil || value != canonical {
+		t.Errorf("Value() = %v, %v", value, err)
+	}
+}
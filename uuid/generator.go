@@ -0,0 +1,180 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// ErrNoTimestamp is returned by Time for UUID versions without an embedded timestamp
+var ErrNoTimestamp = errors.New("UUID version has no timestamp")
+
+// gregorianOffset is the number of 100-ns intervals between the Gregorian
+// epoch (1582-10-15) used by v1/v6 and the Unix epoch
+const gregorianOffset = 122192928000000000
+
+// GeneratorOptions configures a Generator
+type GeneratorOptions struct {
+	Clock   func() time.Time // time source, time.Now by default
+	Entropy io.Reader        // random source, crypto/rand.Reader by default
+}
+
+// Generator produces time-ordered UUIDs (v6, v7) and ULIDs. IDs returned by one generator
+// are strictly increasing even within a millisecond, when called from several
+// goroutines, and when the clock goes backwards. The zero value is not usable;
+// create generators with NewGenerator
+type Generator struct {
+	mu      sync.Mutex
+	clock   func() time.Time
+	entropy io.Reader
+
+	// v7 state: last millisecond and the 12-bit counter in rand_a
+	lastMillis int64
+	counter    uint16
+
+	// v6 state: last 60-bit timestamp, clock sequence and node
+	lastTicks uint64
+	clockSeq  uint16
+	node      [6]byte
+	seeded    bool
+
+	// ULID state: last ULID, incremented within a millisecond
+	lastULID ULID
+}
+
+// NewGenerator creates a generator with the given clock and entropy source
+func NewGenerator(opts ...GeneratorOptions) *Generator {
+	var o GeneratorOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	if o.Clock == nil {
+		o.Clock = time.Now
+	}
+	if o.Entropy == nil {
+		o.Entropy = rand.Reader
+	}
+	return &Generator{clock: o.Clock, entropy: o.Entropy}
+}
+
+var defaultGenerator = NewGenerator()
+
+// NewV7 generates a version 7 UUID (Unix milliseconds plus random bits)
+// using the package-level generator
+func NewV7() (UUID, error) {
+	return defaultGenerator.NewV7()
+}
+
+// NewV6 generates a version 6 UUID (reordered Gregorian timestamp) using
+// the package-level generator
+func NewV6() (UUID, error) {
+	return defaultGenerator.NewV6()
+}
+
+// NewV7 generates a version 7 UUID. Layout per RFC 9562:
+// 48-bit Unix timestamp in milliseconds, version, 12-bit rand_a, variant, 62-bit rand_b.
+// rand_a is used as a counter seeded randomly each millisecond; when it overflows
+// the timestamp is advanced by one millisecond, keeping the IDs strictly increasing
+func (g *Generator) NewV7() (UUID, error) {
+	var uuid UUID
+	g.mu.Lock()
+	// The entropy source is shared and not necessarily safe for concurrent use
+	if _, err := io.ReadFull(g.entropy, uuid[8:]); err != nil {
+		g.mu.Unlock()
+		return Nil, err
+	}
+
+	millis := g.clock().UnixMilli()
+	if millis > g.lastMillis {
+		// Seed the counter in the lower half of its range to leave room for increments
+		var seed [2]byte
+		if _, err := io.ReadFull(g.entropy, seed[:]); err != nil {
+			g.mu.Unlock()
+			return Nil, err
+		}
+		g.lastMillis = millis
+		g.counter = binary.BigEndian.Uint16(seed[:]) & 0x07ff
+	} else {
+		g.counter++
+		if g.counter > 0x0fff {
+			g.lastMillis++
+			g.counter = 0
+		}
+	}
+	millis, counter := g.lastMillis, g.counter
+	g.mu.Unlock()
+
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(millis))
+	copy(uuid[0:6], ts[2:])
+	uuid[6] = 0x70 | byte(counter>>8)
+	uuid[7] = byte(counter)
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+	return uuid, nil
+}
+
+// NewV6 generates a version 6 UUID: the v1 timestamp (100-ns intervals since
+// 1582-10-15) stored most significant bits first, so IDs sort by creation time.
+// The clock sequence and node are random per generator (the node has the
+// multicast bit set as RFC 9562 requires for non-MAC nodes)
+func (g *Generator) NewV6() (UUID, error) {
+	g.mu.Lock()
+	if !g.seeded {
+		var seed [8]byte
+		if _, err := io.ReadFull(g.entropy, seed[:]); err != nil {
+			g.mu.Unlock()
+			return Nil, err
+		}
+		g.clockSeq = binary.BigEndian.Uint16(seed[:2]) & 0x3fff
+		copy(g.node[:], seed[2:])
+		g.node[0] |= 0x01
+		g.seeded = true
+	}
+
+	ticks := uint64(g.clock().UnixNano()/100) + gregorianOffset
+	if ticks <= g.lastTicks {
+		ticks = g.lastTicks + 1
+	}
+	g.lastTicks = ticks
+	clockSeq, node := g.clockSeq, g.node
+	g.mu.Unlock()
+
+	var uuid UUID
+	binary.BigEndian.PutUint32(uuid[0:4], uint32(ticks>>28))
+	binary.BigEndian.PutUint16(uuid[4:6], uint16(ticks>>12))
+	binary.BigEndian.PutUint16(uuid[6:8], 0x6000|uint16(ticks&0x0fff))
+	binary.BigEndian.PutUint16(uuid[8:10], 0x8000|clockSeq)
+	copy(uuid[10:], node[:])
+	return uuid, nil
+}
+
+// Time returns the timestamp embedded in a version 1, 6 or 7 UUID.
+// For other versions it returns ErrNoTimestamp
+func (uuid UUID) Time() (time.Time, error) {
+	switch uuid.Version() {
+	case 1:
+		ticks := uint64(binary.BigEndian.Uint32(uuid[0:4])) |
+			uint64(binary.BigEndian.Uint16(uuid[4:6]))<<32 |
+			uint64(binary.BigEndian.Uint16(uuid[6:8])&0x0fff)<<48
+		return gregorianTime(ticks), nil
+	case 6:
+		ticks := uint64(binary.BigEndian.Uint32(uuid[0:4]))<<28 |
+			uint64(binary.BigEndian.Uint16(uuid[4:6]))<<12 |
+			uint64(binary.BigEndian.Uint16(uuid[6:8])&0x0fff)
+		return gregorianTime(ticks), nil
+	case 7:
+		var ts [8]byte
+		copy(ts[2:], uuid[0:6])
+		return time.UnixMilli(int64(binary.BigEndian.Uint64(ts[:]))), nil
+	}
+	return time.Time{}, ErrNoTimestamp
+}
+
+// gregorianTime converts 100-ns intervals since 1582-10-15 to time.Time
+func gregorianTime(ticks uint64) time.Time {
+	unix := int64(ticks) - gregorianOffset
+	return time.Unix(unix/1e7, unix%1e7*100)
+}
@@ -0,0 +1,187 @@
+package uuid
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fixedClock returns a clock that can be moved by the test
+func fixedClock(t time.Time) (func() time.Time, func(time.Time)) {
+	var mu sync.Mutex
+	return func() time.Time {
+			mu.Lock()
+			defer mu.Unlock()
+			return t
+		}, func(next time.Time) {
+			mu.Lock()
+			t = next
+			mu.Unlock()
+		}
+}
+
+func TestNewV7(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 123e6, time.UTC)
+	clock, _ := fixedClock(now)
+	g := NewGenerator(GeneratorOptions{Clock: clock})
+
+	uuid, err := g.NewV7()
+	if err != nil {
+		t.Fatalf("NewV7() returned error: %v", err)
+	}
+	if uuid[6]>>4 != 7 || uuid[8]>>6 != 2 {
+		t.Errorf("NewV7() version/variant bits wrong: %v", uuid)
+	}
+	ts, err := uuid.Time()
+	if err != nil || !ts.Equal(now) {
+		t.Errorf("Time() = %v, %v, want %v", ts, err, now)
+	}
+
+	if _, err := NewV7(); err != nil {
+		t.Errorf("package NewV7() returned error: %v", err)
+	}
+}
+
+func TestV7MonotonicWithinMillisecond(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	clock, setClock := fixedClock(now)
+	// All-zero entropy makes the random part identical, so ordering comes from the counter
+	g := NewGenerator(GeneratorOptions{Clock: clock, Entropy: bytes.NewReader(make([]byte, 1<<20))})
+
+	var prev UUID
+	for i := 0; i < 5000; i++ { // more than the 12-bit counter holds
+		uuid, err := g.NewV7()
+		if err != nil {
+			t.Fatalf("NewV7() returned error: %v", err)
+		}
+		if bytes.Compare(uuid[:], prev[:]) <= 0 {
+			t.Fatalf("NewV7() #%d = %v not greater than %v", i, uuid, prev)
+		}
+		prev = uuid
+	}
+
+	// The clock going backwards must not break the order
+	setClock(now.Add(-time.Second))
+	uuid, _ := g.NewV7()
+	if bytes.Compare(uuid[:], prev[:]) <= 0 {
+		t.Errorf("NewV7() after clock rollback = %v not greater than %v", uuid, prev)
+	}
+}
+
+func TestGeneratorConcurrent(t *testing.T) {
+	g := NewGenerator()
+	const workers, perWorker = 8, 1000
+
+	results := make([][]UUID, workers)
+	var wg sync.WaitGroup
+	for w := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var prev UUID
+			for i := 0; i < perWorker; i++ {
+				uuid, err := g.NewV7()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if bytes.Compare(uuid[:], prev[:]) <= 0 {
+					t.Errorf("worker %d: %v not greater than %v", w, uuid, prev)
+				}
+				prev = uuid
+				results[w] = append(results[w], uuid)
+			}
+		}()
+	}
+	wg.Wait()
+
+	seen := make(map[UUID]bool, workers*perWorker)
+	for _, list := range results {
+		for _, uuid := range list {
+			if seen[uuid] {
+				t.Fatalf("duplicate UUID %v", uuid)
+			}
+			seen[uuid] = true
+		}
+	}
+}
+
+func TestGeneratorSharedEntropy(t *testing.T) {
+	// bytes.Reader is not safe for concurrent use; the race detector catches unlocked reads
+	g := NewGenerator(GeneratorOptions{Entropy: bytes.NewReader(make([]byte, 1<<20))})
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if _, err := g.NewV7(); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNewV6(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 500, time.UTC)
+	clock, _ := fixedClock(now)
+	g := NewGenerator(GeneratorOptions{Clock: clock})
+
+	var list []UUID
+	for i := 0; i < 100; i++ {
+		uuid, err := g.NewV6()
+		if err != nil {
+			t.Fatalf("NewV6() returned error: %v", err)
+		}
+		if uuid[6]>>4 != 6 || uuid[8]>>6 != 2 {
+			t.Fatalf("NewV6() version/variant bits wrong: %v", uuid)
+		}
+		list = append(list, uuid)
+	}
+	if !sort.SliceIsSorted(list, func(i, j int) bool { return bytes.Compare(list[i][:], list[j][:]) < 0 }) {
+		t.Errorf("NewV6() results are not ordered")
+	}
+
+	// Timestamp has 100-ns resolution: 500 ns is truncated to 500 exactly
+	ts, err := list[0].Time()
+	if err != nil || !ts.Equal(now) {
+		t.Errorf("Time() = %v, %v, want %v", ts, err, now)
+	}
+}
+
+func TestTimeVectors(t *testing.T) {
+	// Test vectors from RFC 9562, appendix A
+	want := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+	for _, s := range []string{
+		"c232ab00-9414-11ec-b3c8-9f6bdeced846", // v1
+		"1ec9414c-232a-6b00-b3c8-9f6bdeced846", // v6
+		"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", // v7
+	} {
+		uuid, _ := FromString(s)
+		ts, err := uuid.Time()
+		if err != nil || !ts.Equal(want) {
+			t.Errorf("%s.Time() = %v, %v, want %v", s, ts, err, want)
+		}
+	}
+
+	v4, _ := New()
+	if _, err := v4.Time(); !errors.Is(err, ErrNoTimestamp) {
+		t.Errorf("v4 Time() error = %v, want ErrNoTimestamp", err)
+	}
+}
+
+func TestGeneratorEntropyError(t *testing.T) {
+	g := NewGenerator(GeneratorOptions{Entropy: bytes.NewReader(nil)})
+	if _, err := g.NewV7(); err == nil {
+		t.Errorf("NewV7() with empty entropy should return error")
+	}
+	if _, err := g.NewV6(); err == nil {
+		t.Errorf("NewV6() with empty entropy should return error")
+	}
+}
@@ -0,0 +1,52 @@
+package uuid
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"hash"
+)
+
+// Predefined namespaces for name-based UUIDs (RFC 9562, section 6.6)
+var (
+	NamespaceDNS  = UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceURL  = UUID{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceOID  = UUID{0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	NamespaceX500 = UUID{0x6b, 0xa7, 0xb8, 0x14, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+)
+
+// NewV3 generates a version 3 UUID from the MD5 hash of namespace and name.
+// The same namespace and name always give the same UUID; prefer NewV5
+func NewV3(namespace UUID, name string) UUID {
+	return newHashed(md5.New(), 3, namespace, name)
+}
+
+// NewV5 generates a version 5 UUID from the SHA-1 hash of namespace and name.
+// The same namespace and name always give the same UUID
+func NewV5(namespace UUID, name string) UUID {
+	return newHashed(sha1.New(), 5, namespace, name)
+}
+
+// NewV8 creates a version 8 UUID with a custom layout: all bits of data are
+// kept except the version and variant bits, which are overwritten
+func NewV8(data [16]byte) UUID {
+	uuid := UUID(data)
+	uuid.setVersion(8)
+	return uuid
+}
+
+// newHashed hashes namespace and name and sets version and variant bits
+func newHashed(h hash.Hash, version byte, namespace UUID, name string) UUID {
+	h.Write(namespace[:])
+	h.Write([]byte(name))
+
+	var uuid UUID
+	copy(uuid[:], h.Sum(nil))
+	uuid.setVersion(version)
+	return uuid
+}
+
+// setVersion sets the version nibble and the RFC 9562 variant bits
+func (uuid *UUID) setVersion(version byte) {
+	uuid[6] = (uuid[6] & 0x0f) | version<<4
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+}
@@ -0,0 +1,76 @@
+package uuid
+
+import "testing"
+
+func TestNameBased(t *testing.T) {
+	// Test vectors from RFC 9562, appendix A
+	if got := NewV3(NamespaceDNS, "www.example.com").String(); got != "5df41881-3aed-3515-88a7-2f4a814cf09e" {
+		t.Errorf("NewV3() = %s", got)
+	}
+	if got := NewV5(NamespaceDNS, "www.example.com").String(); got != "2ed6657d-e927-568b-95e1-2665a8aea6a2" {
+		t.Errorf("NewV5() = %s", got)
+	}
+
+	email := NewV5(NamespaceURL, "mailto:customer@example.com")
+	if email != NewV5(NamespaceURL, "mailto:customer@example.com") {
+		t.Errorf("NewV5() is not deterministic")
+	}
+	if email == NewV5(NamespaceOID, "mailto:customer@example.com") || email == NewV5(NamespaceX500, "mailto:customer@example.com") {
+		t.Errorf("NewV5() should depend on namespace")
+	}
+}
+
+func TestNewV8(t *testing.T) {
+	data := [16]byte{0x24, 0x89, 0xe9, 0xad, 0x2e, 0xe2, 0xfe, 0x00, 0x0e, 0xc9, 0x32, 0xd5, 0xf6, 0x91, 0x81, 0xc0}
+	uuid := NewV8(data)
+	if uuid.String() != "2489e9ad-2ee2-8e00-8ec9-32d5f69181c0" {
+		t.Errorf("NewV8() = %s", uuid)
+	}
+	if uuid.Version() != 8 || uuid.Variant() != VariantRFC9562 {
+		t.Errorf("NewV8() version = %d, variant = %v", uuid.Version(), uuid.Variant())
+	}
+}
+
+func TestVersionVariant(t *testing.T) {
+	v7, _ := NewV7()
+	v6, _ := NewV6()
+	v4, _ := New()
+	tests := []struct {
+		uuid    UUID
+		version int
+		variant Variant
+		valid   bool
+	}{
+		{mustParse(t, "c232ab00-9414-11ec-b3c8-9f6bdeced846"), 1, VariantRFC9562, true},
+		{NewV3(NamespaceDNS, "a"), 3, VariantRFC9562, true},
+		{v4, 4, VariantRFC9562, true},
+		{NewV5(NamespaceDNS, "a"), 5, VariantRFC9562, true},
+		{v6, 6, VariantRFC9562, true},
+		{v7, 7, VariantRFC9562, true},
+		{Nil, 0, VariantNCS, false},
+		{Max, 15, VariantFuture, false},
+		{mustParse(t, "f47ac10b-58cc-4372-0567-0e02b2c3d479"), 4, VariantNCS, false},
+		{mustParse(t, "f47ac10b-58cc-4372-c567-0e02b2c3d479"), 4, VariantMicrosoft, false},
+		{mustParse(t, "f47ac10b-58cc-9372-a567-0e02b2c3d479"), 9, VariantRFC9562, false},
+	}
+	for _, tt := range tests {
+		if got := tt.uuid.Version(); got != tt.version {
+			t.Errorf("%v.Version() = %d, want %d", tt.uuid, got, tt.version)
+		}
+		if got := tt.uuid.Variant(); got != tt.variant {
+			t.Errorf("%v.Variant() = %v, want %v", tt.uuid, got, tt.variant)
+		}
+		if got := tt.uuid.IsValid(); got != tt.valid {
+			t.Errorf("%v.IsValid() = %v, want %v", tt.uuid, got, tt.valid)
+		}
+	}
+}
+
+func mustParse(t *testing.T, s string) UUID {
+	t.Helper()
+	uuid, err := FromString(s)
+	if err != nil {
+		t.Fatalf("FromString(%q) returned error: %v", s, err)
+	}
+	return uuid
+}
@@ -0,0 +1,119 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// ULID is a Universally Unique Lexicographically Sortable Identifier:
+// 48-bit Unix timestamp in milliseconds followed by 80 random bits.
+// Its text form is 26 characters of Crockford Base32 and sorts like the bytes
+type ULID [16]byte
+
+// NewULID generates a ULID using the package-level generator
+func NewULID() (ULID, error) {
+	return defaultGenerator.NewULID()
+}
+
+// NewULID generates a monotonic ULID: within the same millisecond (or when
+// the clock goes backwards) the random part of the previous ULID is incremented
+// by one. If it overflows, the timestamp is advanced by one millisecond
+func (g *Generator) NewULID() (ULID, error) {
+	var ulid ULID
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// Read under the lock: the entropy source is shared with NewV7 and NewV6
+	if _, err := io.ReadFull(g.entropy, ulid[6:]); err != nil {
+		return ULID{}, err
+	}
+
+	millis := g.clock().UnixMilli()
+	if last := g.lastULID.millis(); millis <= last {
+		ulid = g.lastULID
+		if !ulid.incrementRandom() {
+			ulid.setMillis(last + 1)
+		}
+	} else {
+		ulid.setMillis(millis)
+	}
+	g.lastULID = ulid
+	return ulid, nil
+}
+
+// ParseULID parses the 26-character Crockford Base32 form of a ULID
+func ParseULID(s string) (ULID, error) {
+	b, err := decodeBase32(s)
+	return ULID(b), err
+}
+
+// ULIDFromUUID reinterprets the bytes of a UUID as a ULID. For a version 7
+// UUID the ULID carries the same millisecond timestamp
+func ULIDFromUUID(uuid UUID) ULID {
+	return ULID(uuid)
+}
+
+// UUIDv7 converts the ULID to a version 7 UUID with the same timestamp.
+// Six random bits are replaced by the version and variant, so the conversion
+// is lossless only for ULIDs obtained from UUIDv7 with ULIDFromUUID
+func (ulid ULID) UUIDv7() UUID {
+	uuid := UUID(ulid)
+	uuid.setVersion(7)
+	return uuid
+}
+
+// String returns the 26-character Crockford Base32 form
+func (ulid ULID) String() string {
+	return encodeBase32(ulid)
+}
+
+// Time returns the timestamp of the ULID with millisecond precision
+func (ulid ULID) Time() time.Time {
+	return time.UnixMilli(ulid.millis())
+}
+
+// Bytes returns the underlying byte array
+func (ulid ULID) Bytes() []byte {
+	return ulid[:]
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (ulid ULID) MarshalText() ([]byte, error) {
+	return []byte(ulid.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (ulid *ULID) UnmarshalText(data []byte) error {
+	parsed, err := ParseULID(string(data))
+	if err != nil {
+		return err
+	}
+	*ulid = parsed
+	return nil
+}
+
+// millis returns the 48-bit timestamp
+func (ulid ULID) millis() int64 {
+	var ts [8]byte
+	copy(ts[2:], ulid[:6])
+	return int64(binary.BigEndian.Uint64(ts[:]))
+}
+
+// setMillis stores the 48-bit timestamp
+func (ulid *ULID) setMillis(millis int64) {
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(millis))
+	copy(ulid[:6], ts[2:])
+}
+
+// incrementRandom adds one to the 80-bit random part; false on overflow
+func (ulid *ULID) incrementRandom() bool {
+	for i := len(ulid) - 1; i >= 6; i-- {
+		ulid[i]++
+		if ulid[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
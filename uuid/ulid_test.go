@@ -0,0 +1,134 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestULID(t *testing.T) {
+	ulid, err := ParseULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if err != nil {
+		t.Fatalf("ParseULID() returned error: %v", err)
+	}
+	if ulid.String() != "01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+		t.Errorf("String() = %s", ulid)
+	}
+	if want := time.UnixMilli(1469922850259); !ulid.Time().Equal(want) {
+		t.Errorf("Time() = %v, want %v", ulid.Time(), want)
+	}
+
+	data, _ := json.Marshal(ulid)
+	var got ULID
+	if err := json.Unmarshal(data, &got); err != nil || got != ulid {
+		t.Errorf("json round trip = %v, %v", got, err)
+	}
+	if _, err := ParseULID("not a ulid"); err == nil {
+		t.Errorf("ParseULID(invalid) should return error")
+	}
+}
+
+func TestULIDMonotonic(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	clock, setClock := fixedClock(now)
+	g := NewGenerator(GeneratorOptions{Clock: clock})
+
+	first, err := g.NewULID()
+	if err != nil {
+		t.Fatalf("NewULID() returned error: %v", err)
+	}
+	if !first.Time().Equal(now) {
+		t.Errorf("Time() = %v, want %v", first.Time(), now)
+	}
+
+	prev := first
+	for i := 0; i < 1000; i++ {
+		next, _ := g.NewULID()
+		if bytes.Compare(next[:], prev[:]) <= 0 || next.String() <= prev.String() {
+			t.Fatalf("NewULID() #%d = %v not greater than %v", i, next, prev)
+		}
+		prev = next
+	}
+	// Within a millisecond the random part is incremented by one
+	var want ULID = first
+	for i := 0; i < 1000; i++ {
+		want.incrementRandom()
+	}
+	if prev != want {
+		t.Errorf("NewULID() after 1000 calls = %v, want %v", prev, want)
+	}
+
+	setClock(now.Add(-time.Minute))
+	if next, _ := g.NewULID(); bytes.Compare(next[:], prev[:]) <= 0 {
+		t.Errorf("NewULID() after clock rollback = %v not greater than %v", next, prev)
+	}
+
+	// Overflow of the random part moves to the next millisecond
+	full := ULID{}
+	full.setMillis(now.UnixMilli())
+	for i := 6; i < len(full); i++ {
+		full[i] = 0xff
+	}
+	g.lastULID = full
+	next, _ := g.NewULID()
+	if !next.Time().Equal(now.Add(time.Millisecond)) {
+		t.Errorf("NewULID() after overflow time = %v", next.Time())
+	}
+
+	if _, err := NewULID(); err != nil {
+		t.Errorf("package NewULID() returned error: %v", err)
+	}
+}
+
+func TestULIDSharedEntropy(t *testing.T) {
+	// NewULID and NewV7 share a bytes.Reader, which is not safe for concurrent use
+	g := NewGenerator(GeneratorOptions{Entropy: bytes.NewReader(make([]byte, 1<<20))})
+	var wg sync.WaitGroup
+	for w := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				var err error
+				if w%2 == 0 {
+					_, err = g.NewULID()
+				} else {
+					_, err = g.NewV7()
+				}
+				if err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestULIDUUIDv7(t *testing.T) {
+	// UUIDv7 -> ULID -> UUIDv7 is lossless
+	for i := 0; i < 100; i++ {
+		v7, _ := NewV7()
+		ulid := ULIDFromUUID(v7)
+		if ulid.UUIDv7() != v7 {
+			t.Fatalf("round trip %v -> %v -> %v", v7, ulid, ulid.UUIDv7())
+		}
+		ts, _ := v7.Time()
+		if !ulid.Time().Equal(ts) {
+			t.Errorf("ULID time %v, UUID time %v", ulid.Time(), ts)
+		}
+		if ulid.String() != v7.Base32() {
+			t.Errorf("ULID string %s, UUID Base32 %s", ulid, v7.Base32())
+		}
+	}
+
+	// ULID -> UUIDv7 keeps the timestamp and gives a valid v7 UUID
+	ulid, _ := NewULID()
+	v7 := ulid.UUIDv7()
+	ts, err := v7.Time()
+	if err != nil || !ts.Equal(ulid.Time()) || v7.Version() != 7 || !v7.IsValid() {
+		t.Errorf("UUIDv7() = %v (time %v, %v)", v7, ts, err)
+	}
+}
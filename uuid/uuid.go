@@ -14,6 +14,35 @@ type UUID [16]byte
 // Nil is the zero UUID (all zeros)
 var Nil = UUID{}
 
+// urnPrefix is the URN namespace prefix from RFC 9562, section 4
+const urnPrefix = "urn:uuid:"
+
+// Max is the UUID with all bits set (RFC 9562, section 5.10)
+var Max = UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+// Variant describes the layout of a UUID, encoded in the top bits of byte 8
+type Variant byte
+
+const (
+	VariantNCS       Variant = iota // 0xxx: reserved, NCS backward compatibility
+	VariantRFC9562                  // 10xx: RFC 9562 (formerly RFC 4122)
+	VariantMicrosoft                // 110x: reserved, Microsoft backward compatibility
+	VariantFuture                   // 111x: reserved for future definition
+)
+
+// String returns the name of the variant
+func (v Variant) String() string {
+	switch v {
+	case VariantNCS:
+		return "NCS"
+	case VariantRFC9562:
+		return "RFC 9562"
+	case VariantMicrosoft:
+		return "Microsoft"
+	}
+	return "Future"
+}
+
 // New generates a new random UUID
 func New() (UUID, error) {
 	var uuid UUID
@@ -28,27 +57,33 @@ func New() (UUID, error) {
 	return uuid, nil
 }
 
-// FromString creates a UUID from a string representation
+// FromString creates a UUID from a string representation. Accepted forms:
+// canonical "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", 32 hex digits without hyphens,
+// either of them with a "urn:uuid:" prefix or in braces. Hex digits may be
+// upper or lower case; hyphens anywhere else are rejected
 func FromString(s string) (UUID, error) {
 	var uuid UUID
 
-	// Remove hyphens if present
-	s = strings.ReplaceAll(s, "-", "")
+	if len(s) > len(urnPrefix) && strings.EqualFold(s[:len(urnPrefix)], urnPrefix) {
+		s = s[len(urnPrefix):]
+	} else if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+		s = s[1 : len(s)-1]
+	}
 
-	if len(s) != 32 {
+	switch len(s) {
+	case 32:
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return Nil, errors.New("invalid UUID string: misplaced hyphens")
+		}
+		s = s[:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	default:
 		return Nil, errors.New("invalid UUID string length")
 	}
 
-	bytes, err := hex.DecodeString(s)
-	if err != nil {
+	if _, err := hex.Decode(uuid[:], []byte(s)); err != nil {
 		return Nil, fmt.Errorf("invalid UUID string: %w", err)
 	}
-
-	if len(bytes) != 16 {
-		return Nil, errors.New("invalid UUID byte length")
-	}
-
-	copy(uuid[:], bytes)
 	return uuid, nil
 }
 
@@ -67,9 +102,33 @@ func (uuid UUID) Bytes() []byte {
 	return uuid[:]
 }
 
-// IsValid checks if the UUID is valid (not nil)
+// Version returns the version number from the top 4 bits of byte 6.
+// The value is only meaningful for the RFC 9562 variant
+func (uuid UUID) Version() int {
+	return int(uuid[6] >> 4)
+}
+
+// Variant returns the variant from the top bits of byte 8
+func (uuid UUID) Variant() Variant {
+	switch {
+	case uuid[8]&0x80 == 0:
+		return VariantNCS
+	case uuid[8]&0xc0 == 0x80:
+		return VariantRFC9562
+	case uuid[8]&0xe0 == 0xc0:
+		return VariantMicrosoft
+	}
+	return VariantFuture
+}
+
+// IsValid checks if the UUID is valid: not Nil or Max, RFC 9562 variant
+// and one of the defined versions 1-8
 func (uuid UUID) IsValid() bool {
-	return uuid != Nil
+	if uuid == Nil || uuid == Max {
+		return false
+	}
+	version := uuid.Version()
+	return uuid.Variant() == VariantRFC9562 && version >= 1 && version <= 8
 }
 
 // Equal checks if two UUIDs are equal